@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	gen "github.com/kyle-hy/es2go/generator"
+)
+
+// 批量生成和监视模式
+
+// runBatch 并发渲染各索引的代码，存在渲染失败或命名冲突时不写入任何文件
+func runBatch(jobs []*gen.BatchJob, opts gen.BatchOptions, reportPath string, dryRun bool) {
+	results := gen.RenderBatch(jobs, opts)
+	gen.PrintBatchReport(os.Stdout, results)
+	if reportPath != "" {
+		f, err := os.Create(reportPath)
+		if err != nil {
+			fatal("Failed to create report", "path", reportPath, "err", err)
+		}
+		err = gen.WriteBatchReport(f, results)
+		f.Close()
+		if err != nil {
+			fatal("Failed to write report", "path", reportPath, "err", err)
+		}
+	}
+
+	err := checkBatch(results)
+	if err != nil {
+		fatal("No files were written", "err", err)
+	}
+	if dryRun {
+		for _, r := range results {
+			for _, f := range r.Files {
+				fmt.Printf("%s (%d bytes)\n", f.Path, len(f.Source))
+			}
+		}
+		return
+	}
+
+	err = writeBatch(results)
+	if err != nil {
+		fatal(err.Error())
+	}
+}
+
+// checkBatch 输出渲染失败的索引和命名冲突，存在时返回错误
+func checkBatch(results []*gen.BatchResult) error {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			slog.Error("Failed to generate index", "index", r.Job.Name, "err", r.Err)
+			failed++
+		}
+	}
+	collisions := gen.FindCollisions(results)
+	for _, c := range collisions {
+		slog.Error("Collision", "collision", c.String())
+	}
+	if failed > 0 || len(collisions) > 0 {
+		return fmt.Errorf("%d indices failed and %d names collide", failed, len(collisions))
+	}
+	return nil
+}
+
+// writeBatch 写入各索引渲染的文件并生成doc.go
+func writeBatch(results []*gen.BatchResult) error {
+	written, unchanged, deleted := 0, 0, 0
+	for _, r := range results {
+		if r.Fresh {
+			unchanged += len(r.Files)
+			continue
+		}
+		stats, err := gen.WriteIncremental(r.Job.OutputPath, r.Info, r.Hash, r.Files, true)
+		if err != nil {
+			return fmt.Errorf("Failed to write generated code for %s: %v", r.Job.Name, err)
+		}
+		written += len(stats.Written)
+		unchanged += len(stats.Unchanged)
+		deleted += len(stats.Deleted)
+	}
+	err := gen.WriteBatchDocs(results)
+	if err != nil {
+		return fmt.Errorf("Failed to generate doc.go: %v", err)
+	}
+	slog.Info("Wrote files", "indices", len(results), "written", written, "unchanged", unchanged, "removed", deleted)
+	return nil
+}
+
+// runWatch 生成全部索引后监视输入文件，修改后只重新生成依赖该文件的索引并输出新增和删除的函数；
+// roots(配置文件、mapping目录)或不属于任何索引的文件修改时、以及加载任务失败后有文件修改时重新加载全部任务。
+// 生成失败时保留已有的文件，继续监视
+func runWatch(loadJobs func() ([]*gen.BatchJob, error), roots []string, opts gen.BatchOptions, interval, debounce time.Duration) {
+	last := map[string]*gen.BatchResult{}
+	var jobs []*gen.BatchJob
+	affected := []*gen.BatchJob{}
+	reload, failed := true, false
+	for {
+		if reload {
+			loaded, err := loadJobs()
+			failed = err != nil
+			if failed {
+				slog.Error(err.Error())
+				affected = nil
+			} else {
+				jobs, affected = loaded, loaded
+			}
+		}
+
+		// 先记录文件状态，生成期间的修改在下一轮处理
+		paths := slices.Clone(roots)
+		for _, job := range jobs {
+			paths = append(paths, job.WatchPaths()...)
+		}
+		watcher := gen.NewWatcher(paths, interval, debounce)
+
+		if len(affected) > 0 {
+			regenerate(affected, jobs, last, opts)
+		}
+
+		slog.Info("Watching", "paths", len(paths), "indices", len(jobs))
+		changed := watcher.Wait()
+		slog.Info("Changed", "paths", changed)
+
+		// 上次加载失败时任意文件的修改都重新加载，修复出错的mapping或模板后无需再修改配置文件
+		reload, affected = failed, []*gen.BatchJob{}
+		for _, path := range changed {
+			deps := []*gen.BatchJob{}
+			for _, job := range jobs {
+				if job.DependsOn(path) {
+					deps = append(deps, job)
+				}
+			}
+			if len(deps) == 0 {
+				reload = true
+			}
+			for _, job := range deps {
+				if !slices.Contains(affected, job) {
+					affected = append(affected, job)
+				}
+			}
+		}
+	}
+}
+
+// regenerate 重新渲染受影响的索引，与其他索引上次的结果一起检查命名冲突后写入，并输出各索引新增和删除的函数
+func regenerate(affected, jobs []*gen.BatchJob, last map[string]*gen.BatchResult, opts gen.BatchOptions) {
+	results := gen.RenderBatch(affected, opts)
+
+	// 其他索引使用上次成功的结果检查冲突
+	all := slices.Clone(results)
+	for _, job := range jobs {
+		if prev, ok := last[job.Name]; ok && !slices.Contains(affected, job) {
+			all = append(all, prev)
+		}
+	}
+	err := checkBatch(all)
+	if err != nil {
+		slog.Error("No files were written", "err", err)
+		return
+	}
+	err = writeBatch(results)
+	if err != nil {
+		slog.Error(err.Error())
+		return
+	}
+
+	for _, r := range results {
+		if _, ok := last[r.Job.Name]; !ok {
+			slog.Info("Generated index", "index", r.Job.Name, "funcs", r.TotalFuncs())
+			last[r.Job.Name] = r
+			continue
+		}
+		added, removed := gen.DiffFuncs(last[r.Job.Name], r)
+		slog.Info("Regenerated index", "index", r.Job.Name, "funcs", r.TotalFuncs(), "added", len(added), "removed", len(removed), "changes", strings.TrimSpace(summarizeNames(" +", added)+summarizeNames(" -", removed)))
+		last[r.Job.Name] = r
+	}
+}
+
+// summarizeNames 列出前几个函数名称，其余的只输出数量
+func summarizeNames(prefix string, names []string) string {
+	const limit = 5
+	if len(names) == 0 {
+		return ""
+	}
+	s := prefix + strings.Join(names[:min(len(names), limit)], prefix)
+	if len(names) > limit {
+		s += fmt.Sprintf(" ...(%d more)", len(names)-limit)
+	}
+	return s
+}
+
+// batchWatchRoot --batch为目录或通配符时监视mapping文件所在的目录以发现新增和删除的文件，为配置文件时监视配置文件
+func batchWatchRoot(batch string) string {
+	if isManifest(batch) {
+		return batch
+	}
+	if info, err := os.Stat(batch); err == nil && info.IsDir() {
+		return batch
+	}
+	return filepath.Dir(batch)
+}
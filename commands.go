@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	gen "github.com/kyle-hy/es2go/generator"
+)
+
+// 子命令：每个子命令有独立的参数和帮助，model、queries、catalog和plan共用加载mapping的参数
+
+// command 子命令
+type command struct {
+	name    string                           // 子命令名称
+	summary string                           // 一行说明
+	run     func(name string, args []string) // 解析参数并执行
+}
+
+// commands 全部子命令，按帮助中的顺序排列
+var commands []*command
+
+func init() {
+	commands = []*command{
+		{"generate", "Generate the model struct, the common helpers and every query family (default)", runGenerate},
+		{"model", "Generate only the model struct", runModel},
+		{"queries", "Generate the common helpers and the query functions of selected families", runQueries},
+		{"catalog", "List the query functions that would be generated with their comments", runCatalog},
+		{"plan", "Estimate the number of functions each family would emit", runPlan},
+		{"fetch", "Download the mapping of an index from Elasticsearch", runFetch},
+		{"version", "Print the es2go version", runVersion},
+		{"help", "Show help for es2go or a command", runHelp},
+	}
+}
+
+// findCommand 按名称查找子命令，不存在时返回nil
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// printUsage 输出es2go的用法和子命令列表
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: es2go [command] [flags]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\nRun 'es2go <command> -h' for the flags of a command. Without a command es2go runs generate.\n")
+}
+
+// newFlagSet 创建子命令的参数集，-h时输出子命令的说明和参数
+func newFlagSet(name, summary string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		if name == "es2go" {
+			printUsage(fs.Output())
+			fmt.Fprintf(fs.Output(), "\nFlags of generate:\n")
+		} else {
+			fmt.Fprintf(fs.Output(), "Usage: es2go %s [flags]\n\n%s\n\nFlags:\n", name, summary)
+		}
+		fs.PrintDefaults()
+	}
+	addLogFlags(fs)
+	return fs
+}
+
+// parseFamilies 解析逗号分隔的查询类型，检查是否已注册
+func parseFamilies(s string) ([]string, error) {
+	families := []string{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if gen.Lookup(item) == nil {
+			names := []string{}
+			for _, g := range gen.Generators() {
+				names = append(names, g.Name())
+			}
+			return nil, fmt.Errorf("unknown family %q, available: %s", item, strings.Join(names, ", "))
+		}
+		families = append(families, item)
+	}
+	return families, nil
+}
+
+// runModel 只生成模型结构体
+func runModel(name string, args []string) {
+	fs := newFlagSet(name, "Generate only the model struct.")
+	f := newMappingFlags(fs)
+	dryRun := fs.Bool("dry-run", false, "Print the model file that would be written without writing it")
+	f.parse(args)
+
+	job, esInfo, err := f.loadModel()
+	if err != nil {
+		fatal(err.Error())
+	}
+	if *dryRun {
+		fmt.Printf("%s (%d bytes)\n", esInfo.ModelPath, len(esInfo.ModelSource))
+		return
+	}
+
+	hash, err := gen.InputHash(esInfo)
+	if err != nil {
+		fatal("Failed to hash inputs", "err", err)
+	}
+	files := []*gen.OutputFile{{Path: esInfo.ModelPath, Generator: "model", Source: esInfo.ModelSource}}
+	if writeFiles(job.OutputPath, esInfo, hash, files, false) > 0 {
+		os.Exit(1)
+	}
+}
+
+// runQueries 生成通用辅助函数和指定查询类型的查询函数，不生成模型结构体，也不删除其他查询类型的文件
+func runQueries(name string, args []string) {
+	fs := newFlagSet(name, "Generate the common helpers and the query functions of the selected families, leaving the model struct and other families untouched.")
+	f := newMappingFlags(fs)
+	family := fs.String("family", "", "Comma separated query families to generate, e.g. range,term; empty means all")
+	dryRun := fs.Bool("dry-run", false, "Print the files that would be written without writing them")
+	f.parse(args)
+
+	families, err := parseFamilies(*family)
+	if err != nil {
+		fatal(err.Error())
+	}
+	job, esInfo, err := f.loadModel()
+	if err != nil {
+		fatal(err.Error())
+	}
+
+	files, failed := renderQueries(job.OutputPath, esInfo, families)
+	if *dryRun {
+		for _, file := range files {
+			fmt.Printf("%s (%d bytes)\n", file.Path, len(file.Source))
+		}
+	} else {
+		hash, err := gen.InputHash(esInfo)
+		if err != nil {
+			fatal("Failed to hash inputs", "err", err)
+		}
+		failed += writeFiles(job.OutputPath, esInfo, hash, files, false)
+	}
+	if failed > 0 {
+		fatal("Generation failed", "model", esInfo.StructName, "errors", failed)
+	}
+}
+
+// runCatalog 列出将要生成的查询函数及其注释，不渲染代码
+func runCatalog(name string, args []string) {
+	fs := newFlagSet(name, "List the query functions that would be generated with their comments and parameters, without rendering code.")
+	f := newMappingFlags(fs)
+	family := fs.String("family", "", "Comma separated query families to list, empty means all")
+	format := fs.String("format", "json", "Output format: json or text")
+	output := fs.String("output", "", "Write the catalog to this file instead of stdout")
+	f.parse(args)
+
+	families, err := parseFamilies(*family)
+	if err != nil {
+		fatal(err.Error())
+	}
+	_, esInfo, err := f.loadModel()
+	if err != nil {
+		fatal(err.Error())
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fatal("Failed to create catalog", "path", *output, "err", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	err = gen.WriteCatalog(w, gen.Catalog(esInfo, families), *format)
+	if err != nil {
+		fatal("Failed to write catalog", "err", err)
+	}
+}
+
+// runPlan 估算各查询类型的函数数量，按预算抽样时同时输出抽样后的数量
+func runPlan(name string, args []string) {
+	fs := newFlagSet(name, "Estimate the number of functions each query family would emit without enumerating the combinations.")
+	f := newMappingFlags(fs)
+	f.parse(args)
+
+	job, err := f.loadJob()
+	if err != nil {
+		fatal(err.Error())
+	}
+	job.Options.SkipWrite = true
+	esInfo, err := gen.GenEsModel(job.InputPath, job.OutputPath, job.PackageName, job.StructName, job.Options)
+	if err != nil {
+		fatal("Failed to generate data model", "err", err)
+	}
+
+	planItems := gen.PlanFuncs(esInfo)
+	gen.PrintPlan(os.Stdout, esInfo, planItems)
+	err = gen.ApplyBudget(esInfo, planItems, *f.budget, *f.budgetMode)
+	if err != nil {
+		fatal("Budget exceeded", "err", err)
+	}
+	if *f.budget > 0 && *f.budgetMode == gen.BudgetSample {
+		gen.PrintPlan(os.Stdout, esInfo, planItems)
+	}
+}
+
+// runFetch 从es集群下载索引的mapping
+func runFetch(name string, args []string) {
+	fs := newFlagSet(name, "Download the mapping of an index from Elasticsearch and save it in the format expected by --in.")
+	url := fs.String("url", getenv("ES_URL", "http://localhost:9200"), "Elasticsearch URL, defaults to $ES_URL")
+	index := fs.String("index", "", "Index or alias whose mapping is downloaded (required)")
+	username := fs.String("user", "", "Username for basic auth, defaults to $ES_USERNAME")
+	password := fs.String("password", "", "Password for basic auth, defaults to $ES_PASSWORD")
+	apiKey := fs.String("api-key", "", "API key, takes precedence over basic auth, defaults to $ES_API_KEY")
+	output := fs.String("out", "", "Write the mapping to this file instead of stdout")
+	timeout := fs.Duration("timeout", 30*time.Second, "Request timeout")
+	parseFlags(fs, args)
+
+	if *index == "" {
+		fatal("--index must be specified")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	// 认证信息未通过参数指定时取自环境变量，避免出现在帮助的默认值中
+	opts := gen.FetchOptions{
+		URL:      *url,
+		Username: orEnv(*username, "ES_USERNAME"),
+		Password: orEnv(*password, "ES_PASSWORD"),
+		APIKey:   orEnv(*apiKey, "ES_API_KEY"),
+	}
+	data, err := gen.FetchMapping(ctx, *index, opts)
+	if err != nil {
+		fatal(err.Error())
+	}
+
+	if *output == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	err = (&gen.OutputFile{Path: *output, Generator: "fetch", Source: data}).Write()
+	if err != nil {
+		fatal(err.Error())
+	}
+	slog.Info("Saved mapping", "index", *index, "path", *output)
+}
+
+// runVersion 输出es2go的版本
+func runVersion(name string, args []string) {
+	fs := newFlagSet(name, "Print the es2go version.")
+	parseFlags(fs, args)
+	fmt.Printf("es2go %s %s/%s %s\n", gen.BuildVersion(), runtime.GOOS, runtime.GOARCH, runtime.Version())
+}
+
+// runHelp 输出es2go或子命令的帮助
+func runHelp(name string, args []string) {
+	if len(args) == 0 {
+		printUsage(os.Stdout)
+		return
+	}
+	cmd := findCommand(args[0])
+	if cmd == nil || cmd.name == "help" {
+		printUsage(os.Stderr)
+		os.Exit(2)
+	}
+	cmd.run(cmd.name, []string{"-h"})
+}
+
+// getenv 获取环境变量，未设置时使用默认值
+func getenv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// orEnv 参数为空时使用环境变量的值
+func orEnv(value, key string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(key)
+}
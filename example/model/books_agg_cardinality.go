@@ -0,0 +1,560 @@
+// Code generated by es2go. DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/elastic/go-elasticsearch/v8"
+	eq "github.com/kyle-hy/esquery"
+)
+
+// CardinalityBooksClass 查询books中class的去重数量
+func CardinalityBooksClass(es *elasticsearch.Client) (int64, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassBySeq 以seq为过滤条件查询books中class的去重数量
+// seq string seq
+func CardinalityBooksClassBySeq(es *elasticsearch.Client, seq string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassByPageCount 以page_count为过滤条件查询books中class的去重数量
+// pageCount int64 page_count
+func CardinalityBooksClassByPageCount(es *elasticsearch.Client, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassByPrice 以价格为过滤条件查询books中class的去重数量
+// price float64 价格
+func CardinalityBooksClassByPrice(es *elasticsearch.Client, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassBySeqPageCount 以seq、page_count为过滤条件查询books中class的去重数量
+// seq string seq
+// pageCount int64 page_count
+func CardinalityBooksClassBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassBySeqPrice 以seq、价格为过滤条件查询books中class的去重数量
+// seq string seq
+// price float64 价格
+func CardinalityBooksClassBySeqPrice(es *elasticsearch.Client, seq string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassByPageCountPrice 以page_count、价格为过滤条件查询books中class的去重数量
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksClassByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksClassBySeqPageCountPrice 以seq、page_count、价格为过滤条件查询books中class的去重数量
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksClassBySeqPageCountPrice(es *elasticsearch.Client, seq string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "class", query)
+}
+
+// CardinalityBooksSeq 查询books中seq的去重数量
+func CardinalityBooksSeq(es *elasticsearch.Client) (int64, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByClass 以class为过滤条件查询books中seq的去重数量
+// class string class
+func CardinalityBooksSeqByClass(es *elasticsearch.Client, class string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByPageCount 以page_count为过滤条件查询books中seq的去重数量
+// pageCount int64 page_count
+func CardinalityBooksSeqByPageCount(es *elasticsearch.Client, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByPrice 以价格为过滤条件查询books中seq的去重数量
+// price float64 价格
+func CardinalityBooksSeqByPrice(es *elasticsearch.Client, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByClassPageCount 以class、page_count为过滤条件查询books中seq的去重数量
+// class string class
+// pageCount int64 page_count
+func CardinalityBooksSeqByClassPageCount(es *elasticsearch.Client, class string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByClassPrice 以class、价格为过滤条件查询books中seq的去重数量
+// class string class
+// price float64 价格
+func CardinalityBooksSeqByClassPrice(es *elasticsearch.Client, class string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByPageCountPrice 以page_count、价格为过滤条件查询books中seq的去重数量
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksSeqByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksSeqByClassPageCountPrice 以class、page_count、价格为过滤条件查询books中seq的去重数量
+// class string class
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksSeqByClassPageCountPrice(es *elasticsearch.Client, class string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "seq", query)
+}
+
+// CardinalityBooksAuthor 查询books中author的去重数量
+func CardinalityBooksAuthor(es *elasticsearch.Client) (int64, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClass 以class为过滤条件查询books中author的去重数量
+// class string class
+func CardinalityBooksAuthorByClass(es *elasticsearch.Client, class string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorBySeq 以seq为过滤条件查询books中author的去重数量
+// seq string seq
+func CardinalityBooksAuthorBySeq(es *elasticsearch.Client, seq string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByPageCount 以page_count为过滤条件查询books中author的去重数量
+// pageCount int64 page_count
+func CardinalityBooksAuthorByPageCount(es *elasticsearch.Client, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByPrice 以价格为过滤条件查询books中author的去重数量
+// price float64 价格
+func CardinalityBooksAuthorByPrice(es *elasticsearch.Client, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassSeq 以class、seq为过滤条件查询books中author的去重数量
+// class string class
+// seq string seq
+func CardinalityBooksAuthorByClassSeq(es *elasticsearch.Client, class string, seq string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassPageCount 以class、page_count为过滤条件查询books中author的去重数量
+// class string class
+// pageCount int64 page_count
+func CardinalityBooksAuthorByClassPageCount(es *elasticsearch.Client, class string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassPrice 以class、价格为过滤条件查询books中author的去重数量
+// class string class
+// price float64 价格
+func CardinalityBooksAuthorByClassPrice(es *elasticsearch.Client, class string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorBySeqPageCount 以seq、page_count为过滤条件查询books中author的去重数量
+// seq string seq
+// pageCount int64 page_count
+func CardinalityBooksAuthorBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorBySeqPrice 以seq、价格为过滤条件查询books中author的去重数量
+// seq string seq
+// price float64 价格
+func CardinalityBooksAuthorBySeqPrice(es *elasticsearch.Client, seq string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByPageCountPrice 以page_count、价格为过滤条件查询books中author的去重数量
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksAuthorByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassSeqPageCount 以class、seq、page_count为过滤条件查询books中author的去重数量
+// class string class
+// seq string seq
+// pageCount int64 page_count
+func CardinalityBooksAuthorByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassSeqPrice 以class、seq、价格为过滤条件查询books中author的去重数量
+// class string class
+// seq string seq
+// price float64 价格
+func CardinalityBooksAuthorByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassPageCountPrice 以class、page_count、价格为过滤条件查询books中author的去重数量
+// class string class
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksAuthorByClassPageCountPrice(es *elasticsearch.Client, class string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorBySeqPageCountPrice 以seq、page_count、价格为过滤条件查询books中author的去重数量
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksAuthorBySeqPageCountPrice(es *elasticsearch.Client, seq string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksAuthorByClassSeqPageCountPrice 以class、seq、page_count、价格为过滤条件查询books中author的去重数量
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksAuthorByClassSeqPageCountPrice(es *elasticsearch.Client, class string, seq string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "author.keyword", query)
+}
+
+// CardinalityBooksName 查询books中书名的去重数量
+func CardinalityBooksName(es *elasticsearch.Client) (int64, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClass 以class为过滤条件查询books中书名的去重数量
+// class string class
+func CardinalityBooksNameByClass(es *elasticsearch.Client, class string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameBySeq 以seq为过滤条件查询books中书名的去重数量
+// seq string seq
+func CardinalityBooksNameBySeq(es *elasticsearch.Client, seq string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByPageCount 以page_count为过滤条件查询books中书名的去重数量
+// pageCount int64 page_count
+func CardinalityBooksNameByPageCount(es *elasticsearch.Client, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByPrice 以价格为过滤条件查询books中书名的去重数量
+// price float64 价格
+func CardinalityBooksNameByPrice(es *elasticsearch.Client, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassSeq 以class、seq为过滤条件查询books中书名的去重数量
+// class string class
+// seq string seq
+func CardinalityBooksNameByClassSeq(es *elasticsearch.Client, class string, seq string) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassPageCount 以class、page_count为过滤条件查询books中书名的去重数量
+// class string class
+// pageCount int64 page_count
+func CardinalityBooksNameByClassPageCount(es *elasticsearch.Client, class string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassPrice 以class、价格为过滤条件查询books中书名的去重数量
+// class string class
+// price float64 价格
+func CardinalityBooksNameByClassPrice(es *elasticsearch.Client, class string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameBySeqPageCount 以seq、page_count为过滤条件查询books中书名的去重数量
+// seq string seq
+// pageCount int64 page_count
+func CardinalityBooksNameBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameBySeqPrice 以seq、价格为过滤条件查询books中书名的去重数量
+// seq string seq
+// price float64 价格
+func CardinalityBooksNameBySeqPrice(es *elasticsearch.Client, seq string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByPageCountPrice 以page_count、价格为过滤条件查询books中书名的去重数量
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksNameByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassSeqPageCount 以class、seq、page_count为过滤条件查询books中书名的去重数量
+// class string class
+// seq string seq
+// pageCount int64 page_count
+func CardinalityBooksNameByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassSeqPrice 以class、seq、价格为过滤条件查询books中书名的去重数量
+// class string class
+// seq string seq
+// price float64 价格
+func CardinalityBooksNameByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassPageCountPrice 以class、page_count、价格为过滤条件查询books中书名的去重数量
+// class string class
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksNameByClassPageCountPrice(es *elasticsearch.Client, class string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameBySeqPageCountPrice 以seq、page_count、价格为过滤条件查询books中书名的去重数量
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksNameBySeqPageCountPrice(es *elasticsearch.Client, seq string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
+
+// CardinalityBooksNameByClassSeqPageCountPrice 以class、seq、page_count、价格为过滤条件查询books中书名的去重数量
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func CardinalityBooksNameByClassSeqPageCountPrice(es *elasticsearch.Client, class string, seq string, pageCount int64, price float64) (int64, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return cardinalityBooks(es, "name.keyword", query)
+}
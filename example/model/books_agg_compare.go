@@ -0,0 +1,100 @@
+// Code generated by es2go. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	eq "github.com/kyle-hy/esquery"
+)
+
+// CompareBooksCountByReleaseDate 按release_date对比books本期与上期的数量，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksCountByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	var metric eq.Map
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksSumPageCountByReleaseDate 按release_date对比books本期与上期中page_count的总和，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksSumPageCountByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksAvgPageCountByReleaseDate 按release_date对比books本期与上期中page_count的平均值，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksAvgPageCountByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksMinPageCountByReleaseDate 按release_date对比books本期与上期中page_count的最小值，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksMinPageCountByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksMaxPageCountByReleaseDate 按release_date对比books本期与上期中page_count的最大值，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksMaxPageCountByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksSumPriceByReleaseDate 按release_date对比books本期与上期中价格的总和，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksSumPriceByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksAvgPriceByReleaseDate 按release_date对比books本期与上期中价格的平均值，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksAvgPriceByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksMinPriceByReleaseDate 按release_date对比books本期与上期中价格的最小值，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksMinPriceByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
+
+// CompareBooksMaxPriceByReleaseDate 按release_date对比books本期与上期中价格的最大值，返回本期值、上期值、变化量和变化百分比
+// refTime time.Time 参考时间，本期为其所在的统计周期
+// period string 统计周期，可选day、week、month、quarter、year
+// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期
+func CompareBooksMaxPriceByReleaseDate(es *elasticsearch.Client, refTime time.Time, period string, yearOnYear bool) (*BooksPeriodCompare, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return compareBooks(es, "release_date", refTime, period, yearOnYear, metric, query)
+}
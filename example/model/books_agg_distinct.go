@@ -0,0 +1,560 @@
+// Code generated by es2go. DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/elastic/go-elasticsearch/v8"
+	eq "github.com/kyle-hy/esquery"
+)
+
+// DistinctBooksClass 查询books中class的所有去重值
+func DistinctBooksClass(es *elasticsearch.Client) ([]string, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassBySeq 以seq为过滤条件查询books中class的所有去重值
+// seq string seq
+func DistinctBooksClassBySeq(es *elasticsearch.Client, seq string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassByPageCount 以page_count为过滤条件查询books中class的所有去重值
+// pageCount int64 page_count
+func DistinctBooksClassByPageCount(es *elasticsearch.Client, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassByPrice 以价格为过滤条件查询books中class的所有去重值
+// price float64 价格
+func DistinctBooksClassByPrice(es *elasticsearch.Client, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassBySeqPageCount 以seq、page_count为过滤条件查询books中class的所有去重值
+// seq string seq
+// pageCount int64 page_count
+func DistinctBooksClassBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassBySeqPrice 以seq、价格为过滤条件查询books中class的所有去重值
+// seq string seq
+// price float64 价格
+func DistinctBooksClassBySeqPrice(es *elasticsearch.Client, seq string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassByPageCountPrice 以page_count、价格为过滤条件查询books中class的所有去重值
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksClassByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksClassBySeqPageCountPrice 以seq、page_count、价格为过滤条件查询books中class的所有去重值
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksClassBySeqPageCountPrice(es *elasticsearch.Client, seq string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "class", query)
+}
+
+// DistinctBooksSeq 查询books中seq的所有去重值
+func DistinctBooksSeq(es *elasticsearch.Client) ([]string, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByClass 以class为过滤条件查询books中seq的所有去重值
+// class string class
+func DistinctBooksSeqByClass(es *elasticsearch.Client, class string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByPageCount 以page_count为过滤条件查询books中seq的所有去重值
+// pageCount int64 page_count
+func DistinctBooksSeqByPageCount(es *elasticsearch.Client, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByPrice 以价格为过滤条件查询books中seq的所有去重值
+// price float64 价格
+func DistinctBooksSeqByPrice(es *elasticsearch.Client, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByClassPageCount 以class、page_count为过滤条件查询books中seq的所有去重值
+// class string class
+// pageCount int64 page_count
+func DistinctBooksSeqByClassPageCount(es *elasticsearch.Client, class string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByClassPrice 以class、价格为过滤条件查询books中seq的所有去重值
+// class string class
+// price float64 价格
+func DistinctBooksSeqByClassPrice(es *elasticsearch.Client, class string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByPageCountPrice 以page_count、价格为过滤条件查询books中seq的所有去重值
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksSeqByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksSeqByClassPageCountPrice 以class、page_count、价格为过滤条件查询books中seq的所有去重值
+// class string class
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksSeqByClassPageCountPrice(es *elasticsearch.Client, class string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "seq", query)
+}
+
+// DistinctBooksAuthor 查询books中author的所有去重值
+func DistinctBooksAuthor(es *elasticsearch.Client) ([]string, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClass 以class为过滤条件查询books中author的所有去重值
+// class string class
+func DistinctBooksAuthorByClass(es *elasticsearch.Client, class string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorBySeq 以seq为过滤条件查询books中author的所有去重值
+// seq string seq
+func DistinctBooksAuthorBySeq(es *elasticsearch.Client, seq string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByPageCount 以page_count为过滤条件查询books中author的所有去重值
+// pageCount int64 page_count
+func DistinctBooksAuthorByPageCount(es *elasticsearch.Client, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByPrice 以价格为过滤条件查询books中author的所有去重值
+// price float64 价格
+func DistinctBooksAuthorByPrice(es *elasticsearch.Client, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassSeq 以class、seq为过滤条件查询books中author的所有去重值
+// class string class
+// seq string seq
+func DistinctBooksAuthorByClassSeq(es *elasticsearch.Client, class string, seq string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassPageCount 以class、page_count为过滤条件查询books中author的所有去重值
+// class string class
+// pageCount int64 page_count
+func DistinctBooksAuthorByClassPageCount(es *elasticsearch.Client, class string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassPrice 以class、价格为过滤条件查询books中author的所有去重值
+// class string class
+// price float64 价格
+func DistinctBooksAuthorByClassPrice(es *elasticsearch.Client, class string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorBySeqPageCount 以seq、page_count为过滤条件查询books中author的所有去重值
+// seq string seq
+// pageCount int64 page_count
+func DistinctBooksAuthorBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorBySeqPrice 以seq、价格为过滤条件查询books中author的所有去重值
+// seq string seq
+// price float64 价格
+func DistinctBooksAuthorBySeqPrice(es *elasticsearch.Client, seq string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByPageCountPrice 以page_count、价格为过滤条件查询books中author的所有去重值
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksAuthorByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassSeqPageCount 以class、seq、page_count为过滤条件查询books中author的所有去重值
+// class string class
+// seq string seq
+// pageCount int64 page_count
+func DistinctBooksAuthorByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassSeqPrice 以class、seq、价格为过滤条件查询books中author的所有去重值
+// class string class
+// seq string seq
+// price float64 价格
+func DistinctBooksAuthorByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassPageCountPrice 以class、page_count、价格为过滤条件查询books中author的所有去重值
+// class string class
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksAuthorByClassPageCountPrice(es *elasticsearch.Client, class string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorBySeqPageCountPrice 以seq、page_count、价格为过滤条件查询books中author的所有去重值
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksAuthorBySeqPageCountPrice(es *elasticsearch.Client, seq string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksAuthorByClassSeqPageCountPrice 以class、seq、page_count、价格为过滤条件查询books中author的所有去重值
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksAuthorByClassSeqPageCountPrice(es *elasticsearch.Client, class string, seq string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "author.keyword", query)
+}
+
+// DistinctBooksName 查询books中书名的所有去重值
+func DistinctBooksName(es *elasticsearch.Client) ([]string, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClass 以class为过滤条件查询books中书名的所有去重值
+// class string class
+func DistinctBooksNameByClass(es *elasticsearch.Client, class string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameBySeq 以seq为过滤条件查询books中书名的所有去重值
+// seq string seq
+func DistinctBooksNameBySeq(es *elasticsearch.Client, seq string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByPageCount 以page_count为过滤条件查询books中书名的所有去重值
+// pageCount int64 page_count
+func DistinctBooksNameByPageCount(es *elasticsearch.Client, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByPrice 以价格为过滤条件查询books中书名的所有去重值
+// price float64 价格
+func DistinctBooksNameByPrice(es *elasticsearch.Client, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassSeq 以class、seq为过滤条件查询books中书名的所有去重值
+// class string class
+// seq string seq
+func DistinctBooksNameByClassSeq(es *elasticsearch.Client, class string, seq string) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassPageCount 以class、page_count为过滤条件查询books中书名的所有去重值
+// class string class
+// pageCount int64 page_count
+func DistinctBooksNameByClassPageCount(es *elasticsearch.Client, class string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassPrice 以class、价格为过滤条件查询books中书名的所有去重值
+// class string class
+// price float64 价格
+func DistinctBooksNameByClassPrice(es *elasticsearch.Client, class string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameBySeqPageCount 以seq、page_count为过滤条件查询books中书名的所有去重值
+// seq string seq
+// pageCount int64 page_count
+func DistinctBooksNameBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameBySeqPrice 以seq、价格为过滤条件查询books中书名的所有去重值
+// seq string seq
+// price float64 价格
+func DistinctBooksNameBySeqPrice(es *elasticsearch.Client, seq string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByPageCountPrice 以page_count、价格为过滤条件查询books中书名的所有去重值
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksNameByPageCountPrice(es *elasticsearch.Client, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassSeqPageCount 以class、seq、page_count为过滤条件查询books中书名的所有去重值
+// class string class
+// seq string seq
+// pageCount int64 page_count
+func DistinctBooksNameByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassSeqPrice 以class、seq、价格为过滤条件查询books中书名的所有去重值
+// class string class
+// seq string seq
+// price float64 价格
+func DistinctBooksNameByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassPageCountPrice 以class、page_count、价格为过滤条件查询books中书名的所有去重值
+// class string class
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksNameByClassPageCountPrice(es *elasticsearch.Client, class string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameBySeqPageCountPrice 以seq、page_count、价格为过滤条件查询books中书名的所有去重值
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksNameBySeqPageCountPrice(es *elasticsearch.Client, seq string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
+
+// DistinctBooksNameByClassSeqPageCountPrice 以class、seq、page_count、价格为过滤条件查询books中书名的所有去重值
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// price float64 价格
+func DistinctBooksNameByClassSeqPageCountPrice(es *elasticsearch.Client, class string, seq string, pageCount int64, price float64) ([]string, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return distinctBooksTerms[string](es, "name.keyword", query)
+}
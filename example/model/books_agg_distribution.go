@@ -0,0 +1,572 @@
+// Code generated by es2go. DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/elastic/go-elasticsearch/v8"
+	eq "github.com/kyle-hy/esquery"
+)
+
+// PercentilesBooksPageCount 统计books中page_count的百分位数值
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCount(es *elasticsearch.Client, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountByClass 以class为过滤条件统计books中page_count的百分位数值
+// class string class
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountByClass(es *elasticsearch.Client, class string, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountBySeq 以seq为过滤条件统计books中page_count的百分位数值
+// seq string seq
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountBySeq(es *elasticsearch.Client, seq string, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountByPrice 以价格为过滤条件统计books中page_count的百分位数值
+// price float64 价格
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountByPrice(es *elasticsearch.Client, price float64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountByClassSeq 以class、seq为过滤条件统计books中page_count的百分位数值
+// class string class
+// seq string seq
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountByClassSeq(es *elasticsearch.Client, class string, seq string, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountByClassPrice 以class、价格为过滤条件统计books中page_count的百分位数值
+// class string class
+// price float64 价格
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountByClassPrice(es *elasticsearch.Client, class string, price float64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountBySeqPrice 以seq、价格为过滤条件统计books中page_count的百分位数值
+// seq string seq
+// price float64 价格
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountBySeqPrice(es *elasticsearch.Client, seq string, price float64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentilesBooksPageCountByClassSeqPrice 以class、seq、价格为过滤条件统计books中page_count的百分位数值
+// class string class
+// seq string seq
+// price float64 价格
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPageCountByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "page_count", percents, query)
+}
+
+// PercentileRanksBooksPageCount 统计books中page_count指定数值的百分位排名
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCount(es *elasticsearch.Client, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountByClass 以class为过滤条件统计books中page_count指定数值的百分位排名
+// class string class
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountByClass(es *elasticsearch.Client, class string, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountBySeq 以seq为过滤条件统计books中page_count指定数值的百分位排名
+// seq string seq
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountBySeq(es *elasticsearch.Client, seq string, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountByPrice 以价格为过滤条件统计books中page_count指定数值的百分位排名
+// price float64 价格
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountByPrice(es *elasticsearch.Client, price float64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountByClassSeq 以class、seq为过滤条件统计books中page_count指定数值的百分位排名
+// class string class
+// seq string seq
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountByClassSeq(es *elasticsearch.Client, class string, seq string, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountByClassPrice 以class、价格为过滤条件统计books中page_count指定数值的百分位排名
+// class string class
+// price float64 价格
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountByClassPrice(es *elasticsearch.Client, class string, price float64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountBySeqPrice 以seq、价格为过滤条件统计books中page_count指定数值的百分位排名
+// seq string seq
+// price float64 价格
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountBySeqPrice(es *elasticsearch.Client, seq string, price float64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// PercentileRanksBooksPageCountByClassSeqPrice 以class、seq、价格为过滤条件统计books中page_count指定数值的百分位排名
+// class string class
+// seq string seq
+// price float64 价格
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPageCountByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "page_count", values, query)
+}
+
+// HistogramBooksPageCount 统计books中page_count按固定间隔统计的直方图分布
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCount(es *elasticsearch.Client, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountByClass 以class为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// class string class
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountByClass(es *elasticsearch.Client, class string, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountBySeq 以seq为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// seq string seq
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountBySeq(es *elasticsearch.Client, seq string, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountByPrice 以价格为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// price float64 价格
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountByPrice(es *elasticsearch.Client, price float64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountByClassSeq 以class、seq为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// class string class
+// seq string seq
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountByClassSeq(es *elasticsearch.Client, class string, seq string, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountByClassPrice 以class、价格为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// class string class
+// price float64 价格
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountByClassPrice(es *elasticsearch.Client, class string, price float64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountBySeqPrice 以seq、价格为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// seq string seq
+// price float64 价格
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountBySeqPrice(es *elasticsearch.Client, seq string, price float64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// HistogramBooksPageCountByClassSeqPrice 以class、seq、价格为过滤条件统计books中page_count按固定间隔统计的直方图分布
+// class string class
+// seq string seq
+// price float64 价格
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPageCountByClassSeqPrice(es *elasticsearch.Client, class string, seq string, price float64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "page_count", interval, query)
+}
+
+// PercentilesBooksPrice 统计books中价格的百分位数值
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPrice(es *elasticsearch.Client, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceByClass 以class为过滤条件统计books中价格的百分位数值
+// class string class
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceByClass(es *elasticsearch.Client, class string, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceBySeq 以seq为过滤条件统计books中价格的百分位数值
+// seq string seq
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceBySeq(es *elasticsearch.Client, seq string, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceByPageCount 以page_count为过滤条件统计books中价格的百分位数值
+// pageCount int64 page_count
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceByPageCount(es *elasticsearch.Client, pageCount int64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceByClassSeq 以class、seq为过滤条件统计books中价格的百分位数值
+// class string class
+// seq string seq
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceByClassSeq(es *elasticsearch.Client, class string, seq string, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceByClassPageCount 以class、page_count为过滤条件统计books中价格的百分位数值
+// class string class
+// pageCount int64 page_count
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceByClassPageCount(es *elasticsearch.Client, class string, pageCount int64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceBySeqPageCount 以seq、page_count为过滤条件统计books中价格的百分位数值
+// seq string seq
+// pageCount int64 page_count
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentilesBooksPriceByClassSeqPageCount 以class、seq、page_count为过滤条件统计books中价格的百分位数值
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// percents []float64 需要计算的百分位，如50、90、99
+func PercentilesBooksPriceByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64, percents []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentilesBooks(es, "price", percents, query)
+}
+
+// PercentileRanksBooksPrice 统计books中价格指定数值的百分位排名
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPrice(es *elasticsearch.Client, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceByClass 以class为过滤条件统计books中价格指定数值的百分位排名
+// class string class
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceByClass(es *elasticsearch.Client, class string, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceBySeq 以seq为过滤条件统计books中价格指定数值的百分位排名
+// seq string seq
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceBySeq(es *elasticsearch.Client, seq string, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceByPageCount 以page_count为过滤条件统计books中价格指定数值的百分位排名
+// pageCount int64 page_count
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceByPageCount(es *elasticsearch.Client, pageCount int64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceByClassSeq 以class、seq为过滤条件统计books中价格指定数值的百分位排名
+// class string class
+// seq string seq
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceByClassSeq(es *elasticsearch.Client, class string, seq string, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceByClassPageCount 以class、page_count为过滤条件统计books中价格指定数值的百分位排名
+// class string class
+// pageCount int64 page_count
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceByClassPageCount(es *elasticsearch.Client, class string, pageCount int64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceBySeqPageCount 以seq、page_count为过滤条件统计books中价格指定数值的百分位排名
+// seq string seq
+// pageCount int64 page_count
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// PercentileRanksBooksPriceByClassSeqPageCount 以class、seq、page_count为过滤条件统计books中价格指定数值的百分位排名
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// values []float64 需要计算百分位排名的数值
+func PercentileRanksBooksPriceByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64, values []float64) ([]BooksPercentile, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return percentileRanksBooks(es, "price", values, query)
+}
+
+// HistogramBooksPrice 统计books中价格按固定间隔统计的直方图分布
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPrice(es *elasticsearch.Client, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceByClass 以class为过滤条件统计books中价格按固定间隔统计的直方图分布
+// class string class
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceByClass(es *elasticsearch.Client, class string, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceBySeq 以seq为过滤条件统计books中价格按固定间隔统计的直方图分布
+// seq string seq
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceBySeq(es *elasticsearch.Client, seq string, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceByPageCount 以page_count为过滤条件统计books中价格按固定间隔统计的直方图分布
+// pageCount int64 page_count
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceByPageCount(es *elasticsearch.Client, pageCount int64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceByClassSeq 以class、seq为过滤条件统计books中价格按固定间隔统计的直方图分布
+// class string class
+// seq string seq
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceByClassSeq(es *elasticsearch.Client, class string, seq string, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceByClassPageCount 以class、page_count为过滤条件统计books中价格按固定间隔统计的直方图分布
+// class string class
+// pageCount int64 page_count
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceByClassPageCount(es *elasticsearch.Client, class string, pageCount int64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceBySeqPageCount 以seq、page_count为过滤条件统计books中价格按固定间隔统计的直方图分布
+// seq string seq
+// pageCount int64 page_count
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceBySeqPageCount(es *elasticsearch.Client, seq string, pageCount int64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
+
+// HistogramBooksPriceByClassSeqPageCount 以class、seq、page_count为过滤条件统计books中价格按固定间隔统计的直方图分布
+// class string class
+// seq string seq
+// pageCount int64 page_count
+// interval float64 直方图分组的固定间隔
+func HistogramBooksPriceByClassSeqPageCount(es *elasticsearch.Client, class string, seq string, pageCount int64, interval float64) ([]BooksHistogramBucket, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	query := eq.Bool(eq.WithFilter(filters))
+	return histogramBooks(es, "price", interval, query)
+}
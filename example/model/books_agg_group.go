@@ -0,0 +1,2627 @@
+// Code generated by es2go. DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/elastic/go-elasticsearch/v8"
+	eq "github.com/kyle-hy/esquery"
+)
+
+// GroupBooksCountByClass 按class分组统计books的数量
+func GroupBooksCountByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClass 按class分组统计books中page_count的总和
+func GroupBooksSumPageCountByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClass 按class分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClass 按class分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClass 按class分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClass 按class分组统计books中价格的总和
+func GroupBooksSumPriceByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClass 按class分组统计books中价格的平均值
+func GroupBooksAvgPriceByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClass 按class分组统计books中价格的最小值
+func GroupBooksMinPriceByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClass 按class分组统计books中价格的最大值
+func GroupBooksMaxPriceByClass(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeq 按seq分组统计books的数量
+func GroupBooksCountBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeq 按seq分组统计books中page_count的总和
+func GroupBooksSumPageCountBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeq 按seq分组统计books中page_count的平均值
+func GroupBooksAvgPageCountBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeq 按seq分组统计books中page_count的最小值
+func GroupBooksMinPageCountBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeq 按seq分组统计books中page_count的最大值
+func GroupBooksMaxPageCountBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeq 按seq分组统计books中价格的总和
+func GroupBooksSumPriceBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeq 按seq分组统计books中价格的平均值
+func GroupBooksAvgPriceBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeq 按seq分组统计books中价格的最小值
+func GroupBooksMinPriceBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeq 按seq分组统计books中价格的最大值
+func GroupBooksMaxPriceBySeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByAuthor 按author分组统计books的数量
+func GroupBooksCountByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByAuthor 按author分组统计books中page_count的总和
+func GroupBooksSumPageCountByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByAuthor 按author分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByAuthor 按author分组统计books中page_count的最小值
+func GroupBooksMinPageCountByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByAuthor 按author分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByAuthor 按author分组统计books中价格的总和
+func GroupBooksSumPriceByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByAuthor 按author分组统计books中价格的平均值
+func GroupBooksAvgPriceByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByAuthor 按author分组统计books中价格的最小值
+func GroupBooksMinPriceByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByAuthor 按author分组统计books中价格的最大值
+func GroupBooksMaxPriceByAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByName 按书名分组统计books的数量
+func GroupBooksCountByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByName 按书名分组统计books中page_count的总和
+func GroupBooksSumPageCountByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByName 按书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByName 按书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByName 按书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByName 按书名分组统计books中价格的总和
+func GroupBooksSumPriceByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByName 按书名分组统计books中价格的平均值
+func GroupBooksAvgPriceByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByName 按书名分组统计books中价格的最小值
+func GroupBooksMinPriceByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByName 按书名分组统计books中价格的最大值
+func GroupBooksMaxPriceByName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByReleaseDate 按release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByReleaseDate 按release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByReleaseDate 按release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByReleaseDate 按release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByReleaseDate 按release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByReleaseDate 按release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByReleaseDate 按release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByReleaseDate 按release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByReleaseDate 按release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassSeq 按class、seq分组统计books的数量
+func GroupBooksCountByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassSeq 按class、seq分组统计books中page_count的总和
+func GroupBooksSumPageCountByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassSeq 按class、seq分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassSeq 按class、seq分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassSeq 按class、seq分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassSeq 按class、seq分组统计books中价格的总和
+func GroupBooksSumPriceByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassSeq 按class、seq分组统计books中价格的平均值
+func GroupBooksAvgPriceByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassSeq 按class、seq分组统计books中价格的最小值
+func GroupBooksMinPriceByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassSeq 按class、seq分组统计books中价格的最大值
+func GroupBooksMaxPriceByClassSeq(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassAuthor 按class、author分组统计books的数量
+func GroupBooksCountByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassAuthor 按class、author分组统计books中page_count的总和
+func GroupBooksSumPageCountByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassAuthor 按class、author分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassAuthor 按class、author分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassAuthor 按class、author分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassAuthor 按class、author分组统计books中价格的总和
+func GroupBooksSumPriceByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassAuthor 按class、author分组统计books中价格的平均值
+func GroupBooksAvgPriceByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassAuthor 按class、author分组统计books中价格的最小值
+func GroupBooksMinPriceByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassAuthor 按class、author分组统计books中价格的最大值
+func GroupBooksMaxPriceByClassAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassName 按class、书名分组统计books的数量
+func GroupBooksCountByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassName 按class、书名分组统计books中page_count的总和
+func GroupBooksSumPageCountByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassName 按class、书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassName 按class、书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassName 按class、书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassName 按class、书名分组统计books中价格的总和
+func GroupBooksSumPriceByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassName 按class、书名分组统计books中价格的平均值
+func GroupBooksAvgPriceByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassName 按class、书名分组统计books中价格的最小值
+func GroupBooksMinPriceByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassName 按class、书名分组统计books中价格的最大值
+func GroupBooksMaxPriceByClassName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassReleaseDate 按class、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassReleaseDate 按class、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassReleaseDate 按class、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassReleaseDate 按class、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassReleaseDate 按class、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassReleaseDate 按class、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassReleaseDate 按class、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassReleaseDate 按class、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassReleaseDate 按class、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByClassReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeqAuthor 按seq、author分组统计books的数量
+func GroupBooksCountBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeqAuthor 按seq、author分组统计books中page_count的总和
+func GroupBooksSumPageCountBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeqAuthor 按seq、author分组统计books中page_count的平均值
+func GroupBooksAvgPageCountBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeqAuthor 按seq、author分组统计books中page_count的最小值
+func GroupBooksMinPageCountBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeqAuthor 按seq、author分组统计books中page_count的最大值
+func GroupBooksMaxPageCountBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeqAuthor 按seq、author分组统计books中价格的总和
+func GroupBooksSumPriceBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeqAuthor 按seq、author分组统计books中价格的平均值
+func GroupBooksAvgPriceBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeqAuthor 按seq、author分组统计books中价格的最小值
+func GroupBooksMinPriceBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeqAuthor 按seq、author分组统计books中价格的最大值
+func GroupBooksMaxPriceBySeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeqName 按seq、书名分组统计books的数量
+func GroupBooksCountBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeqName 按seq、书名分组统计books中page_count的总和
+func GroupBooksSumPageCountBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeqName 按seq、书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeqName 按seq、书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeqName 按seq、书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeqName 按seq、书名分组统计books中价格的总和
+func GroupBooksSumPriceBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeqName 按seq、书名分组统计books中价格的平均值
+func GroupBooksAvgPriceBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeqName 按seq、书名分组统计books中价格的最小值
+func GroupBooksMinPriceBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeqName 按seq、书名分组统计books中价格的最大值
+func GroupBooksMaxPriceBySeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeqReleaseDate 按seq、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeqReleaseDate 按seq、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeqReleaseDate 按seq、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeqReleaseDate 按seq、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeqReleaseDate 按seq、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeqReleaseDate 按seq、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeqReleaseDate 按seq、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeqReleaseDate 按seq、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeqReleaseDate 按seq、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceBySeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByAuthorName 按author、书名分组统计books的数量
+func GroupBooksCountByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByAuthorName 按author、书名分组统计books中page_count的总和
+func GroupBooksSumPageCountByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByAuthorName 按author、书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByAuthorName 按author、书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByAuthorName 按author、书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByAuthorName 按author、书名分组统计books中价格的总和
+func GroupBooksSumPriceByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByAuthorName 按author、书名分组统计books中价格的平均值
+func GroupBooksAvgPriceByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByAuthorName 按author、书名分组统计books中价格的最小值
+func GroupBooksMinPriceByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByAuthorName 按author、书名分组统计books中价格的最大值
+func GroupBooksMaxPriceByAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByAuthorReleaseDate 按author、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByAuthorReleaseDate 按author、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByAuthorReleaseDate 按author、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByAuthorReleaseDate 按author、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByAuthorReleaseDate 按author、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByAuthorReleaseDate 按author、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByAuthorReleaseDate 按author、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByAuthorReleaseDate 按author、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByAuthorReleaseDate 按author、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByNameReleaseDate 按书名、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByNameReleaseDate 按书名、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByNameReleaseDate 按书名、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByNameReleaseDate 按书名、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByNameReleaseDate 按书名、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByNameReleaseDate 按书名、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByNameReleaseDate 按书名、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByNameReleaseDate 按书名、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByNameReleaseDate 按书名、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassSeqAuthor 按class、seq、author分组统计books的数量
+func GroupBooksCountByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassSeqAuthor 按class、seq、author分组统计books中page_count的总和
+func GroupBooksSumPageCountByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassSeqAuthor 按class、seq、author分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassSeqAuthor 按class、seq、author分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassSeqAuthor 按class、seq、author分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassSeqAuthor 按class、seq、author分组统计books中价格的总和
+func GroupBooksSumPriceByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassSeqAuthor 按class、seq、author分组统计books中价格的平均值
+func GroupBooksAvgPriceByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassSeqAuthor 按class、seq、author分组统计books中价格的最小值
+func GroupBooksMinPriceByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassSeqAuthor 按class、seq、author分组统计books中价格的最大值
+func GroupBooksMaxPriceByClassSeqAuthor(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassSeqName 按class、seq、书名分组统计books的数量
+func GroupBooksCountByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassSeqName 按class、seq、书名分组统计books中page_count的总和
+func GroupBooksSumPageCountByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassSeqName 按class、seq、书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassSeqName 按class、seq、书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassSeqName 按class、seq、书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassSeqName 按class、seq、书名分组统计books中价格的总和
+func GroupBooksSumPriceByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassSeqName 按class、seq、书名分组统计books中价格的平均值
+func GroupBooksAvgPriceByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassSeqName 按class、seq、书名分组统计books中价格的最小值
+func GroupBooksMinPriceByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassSeqName 按class、seq、书名分组统计books中价格的最大值
+func GroupBooksMaxPriceByClassSeqName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassSeqReleaseDate 按class、seq、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassSeqReleaseDate 按class、seq、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassSeqReleaseDate 按class、seq、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassSeqReleaseDate 按class、seq、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassSeqReleaseDate 按class、seq、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassSeqReleaseDate 按class、seq、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassSeqReleaseDate 按class、seq、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassSeqReleaseDate 按class、seq、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassSeqReleaseDate 按class、seq、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByClassSeqReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassAuthorName 按class、author、书名分组统计books的数量
+func GroupBooksCountByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassAuthorName 按class、author、书名分组统计books中page_count的总和
+func GroupBooksSumPageCountByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassAuthorName 按class、author、书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassAuthorName 按class、author、书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassAuthorName 按class、author、书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassAuthorName 按class、author、书名分组统计books中价格的总和
+func GroupBooksSumPriceByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassAuthorName 按class、author、书名分组统计books中价格的平均值
+func GroupBooksAvgPriceByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassAuthorName 按class、author、书名分组统计books中价格的最小值
+func GroupBooksMinPriceByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassAuthorName 按class、author、书名分组统计books中价格的最大值
+func GroupBooksMaxPriceByClassAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassAuthorReleaseDate 按class、author、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassAuthorReleaseDate 按class、author、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassAuthorReleaseDate 按class、author、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassAuthorReleaseDate 按class、author、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassAuthorReleaseDate 按class、author、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassAuthorReleaseDate 按class、author、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassAuthorReleaseDate 按class、author、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassAuthorReleaseDate 按class、author、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassAuthorReleaseDate 按class、author、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByClassAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByClassNameReleaseDate 按class、书名、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByClassNameReleaseDate 按class、书名、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByClassNameReleaseDate 按class、书名、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByClassNameReleaseDate 按class、书名、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByClassNameReleaseDate 按class、书名、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByClassNameReleaseDate 按class、书名、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByClassNameReleaseDate 按class、书名、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByClassNameReleaseDate 按class、书名、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByClassNameReleaseDate 按class、书名、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByClassNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"class": eq.Map{"terms": eq.Map{"field": "class"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeqAuthorName 按seq、author、书名分组统计books的数量
+func GroupBooksCountBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeqAuthorName 按seq、author、书名分组统计books中page_count的总和
+func GroupBooksSumPageCountBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeqAuthorName 按seq、author、书名分组统计books中page_count的平均值
+func GroupBooksAvgPageCountBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeqAuthorName 按seq、author、书名分组统计books中page_count的最小值
+func GroupBooksMinPageCountBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeqAuthorName 按seq、author、书名分组统计books中page_count的最大值
+func GroupBooksMaxPageCountBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeqAuthorName 按seq、author、书名分组统计books中价格的总和
+func GroupBooksSumPriceBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeqAuthorName 按seq、author、书名分组统计books中价格的平均值
+func GroupBooksAvgPriceBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeqAuthorName 按seq、author、书名分组统计books中价格的最小值
+func GroupBooksMinPriceBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeqAuthorName 按seq、author、书名分组统计books中价格的最大值
+func GroupBooksMaxPriceBySeqAuthorName(es *elasticsearch.Client) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeqAuthorReleaseDate 按seq、author、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeqAuthorReleaseDate 按seq、author、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceBySeqAuthorReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountBySeqNameReleaseDate 按seq、书名、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountBySeqNameReleaseDate 按seq、书名、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountBySeqNameReleaseDate 按seq、书名、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountBySeqNameReleaseDate 按seq、书名、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountBySeqNameReleaseDate 按seq、书名、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceBySeqNameReleaseDate 按seq、书名、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceBySeqNameReleaseDate 按seq、书名、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceBySeqNameReleaseDate 按seq、书名、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceBySeqNameReleaseDate 按seq、书名、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceBySeqNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"seq": eq.Map{"terms": eq.Map{"field": "seq"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksCountByAuthorNameReleaseDate 按author、书名、release_date分组统计books的数量
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksCountByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	var metric eq.Map
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPageCountByAuthorNameReleaseDate 按author、书名、release_date分组统计books中page_count的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPageCountByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPageCountByAuthorNameReleaseDate 按author、书名、release_date分组统计books中page_count的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPageCountByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPageCountByAuthorNameReleaseDate 按author、书名、release_date分组统计books中page_count的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPageCountByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPageCountByAuthorNameReleaseDate 按author、书名、release_date分组统计books中page_count的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPageCountByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "page_count"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksSumPriceByAuthorNameReleaseDate 按author、书名、release_date分组统计books中价格的总和
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksSumPriceByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"sum": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksAvgPriceByAuthorNameReleaseDate 按author、书名、release_date分组统计books中价格的平均值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksAvgPriceByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"avg": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMinPriceByAuthorNameReleaseDate 按author、书名、release_date分组统计books中价格的最小值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMinPriceByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"min": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
+
+// GroupBooksMaxPriceByAuthorNameReleaseDate 按author、书名、release_date分组统计books中价格的最大值
+// releaseDateInterval string release_date的分组间隔，如day、week、month、quarter、year
+func GroupBooksMaxPriceByAuthorNameReleaseDate(es *elasticsearch.Client, releaseDateInterval string) ([]BooksGroupRow, *eq.Query, error) {
+	query := eq.Map{"match_all": eq.Map{}}
+	sources := []eq.Map{
+		{"author": eq.Map{"terms": eq.Map{"field": "author.keyword"}}},
+		{"name": eq.Map{"terms": eq.Map{"field": "name.keyword"}}},
+		{"release_date": eq.Map{"date_histogram": eq.Map{"field": "release_date", "calendar_interval": releaseDateInterval, "format": "yyyy-MM-dd"}}},
+	}
+	metric := eq.Map{"max": eq.Map{"field": "price"}}
+	return groupBooks(es, sources, metric, query)
+}
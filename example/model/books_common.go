@@ -0,0 +1,468 @@
+// Code generated by es2go. DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	eq "github.com/kyle-hy/esquery"
+)
+
+// 根据query条件查询books详细数据列表和总数量
+func queryBooksList(es *elasticsearch.Client, esQuery *eq.ESQuery) (*eq.Data, *eq.Query, error) {
+	l, t, err := eq.QueryList[Books](es, "books", esQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := &eq.Data{Detail: l, Total: t}
+	qinfo := &eq.Query{Index: "books", DSL: esQuery}
+	return data, qinfo, nil
+}
+
+// 根据DSL执行books的检索，响应结果解析到rsp
+func searchBooks(es *elasticsearch.Client, body eq.Map, rsp any) error {
+	dsl, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	res, err := es.Search(
+		es.Search.WithIndex("books"),
+		es.Search.WithBody(bytes.NewReader(dsl)),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("search books failed: %s", res.String())
+	}
+
+	return json.NewDecoder(res.Body).Decode(rsp)
+}
+
+// 根据DSL执行books的聚合查询，返回各聚合项的原始结果
+func aggBooks(es *elasticsearch.Client, body eq.Map) (map[string]json.RawMessage, error) {
+	var rsp struct {
+		Aggregations map[string]json.RawMessage `json:"aggregations"`
+	}
+	err := searchBooks(es, body, &rsp)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Aggregations, nil
+}
+
+// BooksHighlightHit 带高亮片段的检索结果
+type BooksHighlightHit struct {
+	Doc       *Books              `json:"doc"`       // 命中的数据
+	Highlight map[string][]string `json:"highlight"` // 各命中字段的高亮片段
+}
+
+// BooksHighlightData 带高亮片段的检索结果列表和总数量
+type BooksHighlightData struct {
+	Detail []*BooksHighlightHit `json:"detail"`
+	Total  int64                `json:"total"`
+}
+
+// 根据query条件检索books详细数据列表和总数量，并返回fields字段的高亮片段，esQuery的排序、分页等条件与queryBooksList相同
+func queryBooksHighlight(es *elasticsearch.Client, esQuery *eq.ESQuery, fields []string) (*BooksHighlightData, *eq.Query, error) {
+	// 保留esQuery的全部条件，再追加高亮
+	dsl, err := json.Marshal(esQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := eq.Map{}
+	err = json.Unmarshal(dsl, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+	hlFields := eq.Map{}
+	for _, f := range fields {
+		hlFields[f] = eq.Map{}
+	}
+	body["highlight"] = eq.Map{"fields": hlFields}
+
+	var rsp struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source    *Books              `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	err = searchBooks(es, body, &rsp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := &BooksHighlightData{Total: rsp.Hits.Total.Value}
+	for _, hit := range rsp.Hits.Hits {
+		data.Detail = append(data.Detail, &BooksHighlightHit{Doc: hit.Source, Highlight: hit.Highlight})
+	}
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return data, qinfo, nil
+}
+
+// 根据query条件使用composite聚合分页遍历books的所有分组桶，返回第一页的DSL
+func compositeBooks(es *elasticsearch.Client, query any, sources []eq.Map, subAggs eq.Map, visit func(buckets []json.RawMessage) error) (eq.Map, error) {
+	var first eq.Map
+	var after json.RawMessage
+	for {
+		composite := eq.Map{"size": 1000, "sources": sources}
+		if after != nil {
+			composite["after"] = after
+		}
+		group := eq.Map{"composite": composite}
+		if len(subAggs) > 0 {
+			group["aggs"] = subAggs
+		}
+		body := eq.Map{"size": 0, "query": query, "aggs": eq.Map{"group": group}}
+		if first == nil {
+			first = body
+		}
+
+		aggs, err := aggBooks(es, body)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			AfterKey json.RawMessage   `json:"after_key"`
+			Buckets  []json.RawMessage `json:"buckets"`
+		}
+		err = json.Unmarshal(aggs["group"], &page)
+		if err != nil {
+			return nil, err
+		}
+		err = visit(page.Buckets)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Buckets) == 0 || page.AfterKey == nil {
+			return first, nil
+		}
+		after = page.AfterKey
+	}
+}
+
+// 根据query条件使用terms聚合查询books中field字段的所有去重值，去重值超出terms聚合的数量上限时改用composite聚合分页获取
+func distinctBooksTerms[T any](es *elasticsearch.Client, field string, query any) ([]T, *eq.Query, error) {
+	body := eq.Map{
+		"size":  0,
+		"query": query,
+		"aggs": eq.Map{
+			"distinct": eq.Map{"terms": eq.Map{"field": field, "size": 10000}},
+		},
+	}
+	aggs, err := aggBooks(es, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var agg struct {
+		SumOtherDocCount int64 `json:"sum_other_doc_count"`
+		Buckets          []struct {
+			Key T `json:"key"`
+		} `json:"buckets"`
+	}
+	err = json.Unmarshal(aggs["distinct"], &agg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if agg.SumOtherDocCount > 0 {
+		return distinctBooksComposite[T](es, field, query)
+	}
+
+	vals := make([]T, 0, len(agg.Buckets))
+	for _, b := range agg.Buckets {
+		vals = append(vals, b.Key)
+	}
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return vals, qinfo, nil
+}
+
+// 根据query条件使用composite聚合分页查询books中高基数field字段的所有去重值
+func distinctBooksComposite[T any](es *elasticsearch.Client, field string, query any) ([]T, *eq.Query, error) {
+	vals := []T{}
+	sources := []eq.Map{
+		{"value": eq.Map{"terms": eq.Map{"field": field}}},
+	}
+	body, err := compositeBooks(es, query, sources, nil, func(buckets []json.RawMessage) error {
+		for _, raw := range buckets {
+			var b struct {
+				Key struct {
+					Value T `json:"value"`
+				} `json:"key"`
+			}
+			err := json.Unmarshal(raw, &b)
+			if err != nil {
+				return err
+			}
+			vals = append(vals, b.Key.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return vals, qinfo, nil
+}
+
+// 根据query条件使用cardinality聚合查询books中field字段的去重数量
+func cardinalityBooks(es *elasticsearch.Client, field string, query any) (int64, *eq.Query, error) {
+	body := eq.Map{
+		"size":  0,
+		"query": query,
+		"aggs": eq.Map{
+			"cardinality": eq.Map{"cardinality": eq.Map{"field": field}},
+		},
+	}
+	aggs, err := aggBooks(es, body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var agg struct {
+		Value int64 `json:"value"`
+	}
+	err = json.Unmarshal(aggs["cardinality"], &agg)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return agg.Value, qinfo, nil
+}
+
+// BooksPercentile 百分位统计结果，百分位查询时Key为百分位、Value为数值，百分位排名查询时Key为数值、Value为百分位
+type BooksPercentile struct {
+	Key   float64 `json:"key"`
+	Value float64 `json:"value"`
+}
+
+// BooksHistogramBucket 直方图分组结果
+type BooksHistogramBucket struct {
+	Key   float64 `json:"key"`       // 分组区间的起始值
+	Count int64   `json:"doc_count"` // 分组的文档数量
+}
+
+// 根据query条件使用percentiles聚合统计books中field字段的百分位数值
+func percentilesBooks(es *elasticsearch.Client, field string, percents []float64, query any) ([]BooksPercentile, *eq.Query, error) {
+	percentiles := eq.Map{"field": field, "keyed": false}
+	if len(percents) > 0 {
+		percentiles["percents"] = percents
+	}
+	return percentileAggBooks(es, eq.Map{"percentiles": percentiles}, query)
+}
+
+// 根据query条件使用percentile_ranks聚合统计books中field字段指定数值的百分位排名
+func percentileRanksBooks(es *elasticsearch.Client, field string, values []float64, query any) ([]BooksPercentile, *eq.Query, error) {
+	ranks := eq.Map{"field": field, "values": values, "keyed": false}
+	return percentileAggBooks(es, eq.Map{"percentile_ranks": ranks}, query)
+}
+
+// 执行books的百分位类聚合并解析结果
+func percentileAggBooks(es *elasticsearch.Client, agg eq.Map, query any) ([]BooksPercentile, *eq.Query, error) {
+	body := eq.Map{
+		"size":  0,
+		"query": query,
+		"aggs":  eq.Map{"percentiles": agg},
+	}
+	aggs, err := aggBooks(es, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rsp struct {
+		Values []BooksPercentile `json:"values"`
+	}
+	err = json.Unmarshal(aggs["percentiles"], &rsp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return rsp.Values, qinfo, nil
+}
+
+// 根据query条件使用histogram聚合按固定间隔统计books中field字段的分布
+func histogramBooks(es *elasticsearch.Client, field string, interval float64, query any) ([]BooksHistogramBucket, *eq.Query, error) {
+	body := eq.Map{
+		"size":  0,
+		"query": query,
+		"aggs": eq.Map{
+			"histogram": eq.Map{"histogram": eq.Map{"field": field, "interval": interval}},
+		},
+	}
+	aggs, err := aggBooks(es, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rsp struct {
+		Buckets []BooksHistogramBucket `json:"buckets"`
+	}
+	err = json.Unmarshal(aggs["histogram"], &rsp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return rsp.Buckets, qinfo, nil
+}
+
+// BooksGroupRow 分组统计的一行结果，Keys为各分组维度的取值
+type BooksGroupRow struct {
+	Keys  map[string]any `json:"keys"`  // 分组维度的取值
+	Count int64          `json:"count"` // 分组的文档数量
+	Value float64        `json:"value"` // 分组的统计指标值，仅统计数量时为空
+}
+
+// 根据query条件使用composite聚合按sources维度分组统计books的metric指标，自动分页获取所有分组
+func groupBooks(es *elasticsearch.Client, sources []eq.Map, metric eq.Map, query any) ([]BooksGroupRow, *eq.Query, error) {
+	var subAggs eq.Map
+	if metric != nil {
+		subAggs = eq.Map{"metric": metric}
+	}
+
+	rows := []BooksGroupRow{}
+	body, err := compositeBooks(es, query, sources, subAggs, func(buckets []json.RawMessage) error {
+		for _, raw := range buckets {
+			var b struct {
+				Key      map[string]any `json:"key"`
+				DocCount int64          `json:"doc_count"`
+				Metric   struct {
+					Value float64 `json:"value"`
+				} `json:"metric"`
+			}
+			err := json.Unmarshal(raw, &b)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, BooksGroupRow{Keys: b.Key, Count: b.DocCount, Value: b.Metric.Value})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return rows, qinfo, nil
+}
+
+// BooksPeriodCompare 本期与上期的对比结果
+type BooksPeriodCompare struct {
+	CurrentStart  time.Time `json:"current_start"`  // 本期的开始时间
+	PreviousStart time.Time `json:"previous_start"` // 上期的开始时间
+	Current       float64   `json:"current"`        // 本期的统计值
+	Previous      float64   `json:"previous"`       // 上期的统计值
+	Delta         float64   `json:"delta"`          // 本期相对上期的变化量
+	Percent       float64   `json:"percent"`        // 本期相对上期的变化百分比，上期为0时为0
+}
+
+// 计算refTime所在统计周期的起止时间，周以周一为开始
+func periodRangeBooks(refTime time.Time, period string) (time.Time, time.Time, error) {
+	y, m, d := refTime.Date()
+	loc := refTime.Location()
+	switch period {
+	case "day":
+		start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 0, 1), nil
+	case "week":
+		start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7), nil
+	case "month":
+		start := time.Date(y, m, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 1, 0), nil
+	case "quarter":
+		start := time.Date(y, m-(m-1)%3, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 3, 0), nil
+	case "year":
+		start := time.Date(y, 1, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(1, 0, 0), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("unsupported period: %s", period)
+}
+
+// 根据query条件使用date_range聚合对比books中field字段本期与上期的metric指标，metric为空时对比文档数量
+func compareBooks(es *elasticsearch.Client, field string, refTime time.Time, period string, yearOnYear bool, metric eq.Map, query any) (*BooksPeriodCompare, *eq.Query, error) {
+	curStart, curEnd, err := periodRangeBooks(refTime, period)
+	if err != nil {
+		return nil, nil, err
+	}
+	prevStart, prevEnd, _ := periodRangeBooks(curStart.Add(-time.Nanosecond), period)
+	if yearOnYear {
+		prevStart, prevEnd = curStart.AddDate(-1, 0, 0), curEnd.AddDate(-1, 0, 0)
+	}
+
+	dateRange := eq.Map{
+		"field":  field,
+		"format": "epoch_millis",
+		"keyed":  true,
+		"ranges": []eq.Map{
+			{"key": "previous", "from": prevStart.UnixMilli(), "to": prevEnd.UnixMilli()},
+			{"key": "current", "from": curStart.UnixMilli(), "to": curEnd.UnixMilli()},
+		},
+	}
+	compare := eq.Map{"date_range": dateRange}
+	if metric != nil {
+		compare["aggs"] = eq.Map{"metric": metric}
+	}
+	body := eq.Map{
+		"size":  0,
+		"query": query,
+		"aggs":  eq.Map{"compare": compare},
+	}
+	aggs, err := aggBooks(es, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rsp struct {
+		Buckets map[string]struct {
+			DocCount int64 `json:"doc_count"`
+			Metric   struct {
+				Value float64 `json:"value"`
+			} `json:"metric"`
+		} `json:"buckets"`
+	}
+	err = json.Unmarshal(aggs["compare"], &rsp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value := func(key string) float64 {
+		b := rsp.Buckets[key]
+		if metric == nil {
+			return float64(b.DocCount)
+		}
+		return b.Metric.Value
+	}
+	result := &BooksPeriodCompare{
+		CurrentStart:  curStart,
+		PreviousStart: prevStart,
+		Current:       value("current"),
+		Previous:      value("previous"),
+	}
+	result.Delta = result.Current - result.Previous
+	if result.Previous != 0 {
+		result.Percent = result.Delta / result.Previous * 100
+	}
+
+	qinfo := &eq.Query{Index: "books", DSL: body}
+	return result, qinfo, nil
+}
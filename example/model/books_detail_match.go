@@ -17,6 +17,15 @@ func MatchBooksByAuthor(es *elasticsearch.Client, author string) (*eq.Data, *eq.
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorHighlight 与MatchBooksByAuthor的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorHighlight(es *elasticsearch.Client, author string) (*BooksHighlightData, *eq.Query, error) {
+	esQuery := &eq.ESQuery{
+		Query: eq.Match("author", author),
+	}
+
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByName 对书名进行检索查询books的详细数据列表和总数量
 // name string 书名
 func MatchBooksByName(es *elasticsearch.Client, name string) (*eq.Data, *eq.Query, error) {
@@ -27,6 +36,15 @@ func MatchBooksByName(es *elasticsearch.Client, name string) (*eq.Data, *eq.Quer
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameHighlight 与MatchBooksByName的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameHighlight(es *elasticsearch.Client, name string) (*BooksHighlightData, *eq.Query, error) {
+	esQuery := &eq.ESQuery{
+		Query: eq.Match("name", name),
+	}
+
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorName 对author、书名进行检索查询books的详细数据列表和总数量
 // author string author
 // name string 书名
@@ -39,14 +57,12 @@ func MatchBooksByAuthorName(es *elasticsearch.Client, author string, name string
 	return queryBooksList(es, esQuery)
 }
 
-// 根据query条件查询books详细数据列表和总数量
-func queryBooksList(es *elasticsearch.Client, esQuery *eq.ESQuery) (*eq.Data, *eq.Query, error) {
-	l, t, err := eq.QueryList[Books](es, "books", esQuery)
-	if err != nil {
-		return nil, nil, err
+// MatchBooksByAuthorNameHighlight 与MatchBooksByAuthorName的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorNameHighlight(es *elasticsearch.Client, author string, name string) (*BooksHighlightData, *eq.Query, error) {
+	matches := []eq.Map{
+		eq.Match("author", author),
+		eq.Match("name", name),
 	}
-
-	data := &eq.Data{Detail: l, Total: t}
-	qinfo := &eq.Query{Index: "books", DSL: esQuery}
-	return data, qinfo, nil
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author", "name"})
 }
@@ -21,6 +21,18 @@ func MatchBooksByAuthorFilterClass(es *elasticsearch.Client, class string, autho
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassHighlight 与MatchBooksByAuthorFilterClass的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassHighlight(es *elasticsearch.Client, class string, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClass 以class为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // name string 书名
@@ -35,6 +47,18 @@ func MatchBooksByNameFilterClass(es *elasticsearch.Client, class string, name st
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassHighlight 与MatchBooksByNameFilterClass的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassHighlight(es *elasticsearch.Client, class string, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterSeq 以seq为过滤条件对author进行检索查询books的详细数据列表和总数量
 // seq string seq
 // author string author
@@ -49,6 +73,18 @@ func MatchBooksByAuthorFilterSeq(es *elasticsearch.Client, seq string, author st
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterSeqHighlight 与MatchBooksByAuthorFilterSeq的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterSeqHighlight(es *elasticsearch.Client, seq string, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterSeq 以seq为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // seq string seq
 // name string 书名
@@ -63,6 +99,18 @@ func MatchBooksByNameFilterSeq(es *elasticsearch.Client, seq string, name string
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterSeqHighlight 与MatchBooksByNameFilterSeq的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterSeqHighlight(es *elasticsearch.Client, seq string, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterPageCount 以page_count为过滤条件对author进行检索查询books的详细数据列表和总数量
 // pageCount int64 page_count
 // author string author
@@ -77,6 +125,18 @@ func MatchBooksByAuthorFilterPageCount(es *elasticsearch.Client, pageCount int64
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterPageCountHighlight 与MatchBooksByAuthorFilterPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterPageCountHighlight(es *elasticsearch.Client, pageCount int64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterPageCount 以page_count为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // pageCount int64 page_count
 // name string 书名
@@ -91,6 +151,18 @@ func MatchBooksByNameFilterPageCount(es *elasticsearch.Client, pageCount int64,
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterPageCountHighlight 与MatchBooksByNameFilterPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterPageCountHighlight(es *elasticsearch.Client, pageCount int64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterPrice 以价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // price float64 价格
 // author string author
@@ -105,6 +177,18 @@ func MatchBooksByAuthorFilterPrice(es *elasticsearch.Client, price float64, auth
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterPriceHighlight 与MatchBooksByAuthorFilterPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterPriceHighlight(es *elasticsearch.Client, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterPrice 以价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // price float64 价格
 // name string 书名
@@ -119,6 +203,18 @@ func MatchBooksByNameFilterPrice(es *elasticsearch.Client, price float64, name s
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterPriceHighlight 与MatchBooksByNameFilterPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterPriceHighlight(es *elasticsearch.Client, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassSeq 以class、seq为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -135,6 +231,19 @@ func MatchBooksByAuthorFilterClassSeq(es *elasticsearch.Client, class string, se
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassSeqHighlight 与MatchBooksByAuthorFilterClassSeq的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassSeqHighlight(es *elasticsearch.Client, class string, seq string, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassSeq 以class、seq为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -151,6 +260,19 @@ func MatchBooksByNameFilterClassSeq(es *elasticsearch.Client, class string, seq
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassSeqHighlight 与MatchBooksByNameFilterClassSeq的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassSeqHighlight(es *elasticsearch.Client, class string, seq string, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassPageCount 以class、page_count为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // pageCount int64 page_count
@@ -167,6 +289,19 @@ func MatchBooksByAuthorFilterClassPageCount(es *elasticsearch.Client, class stri
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassPageCountHighlight 与MatchBooksByAuthorFilterClassPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassPageCountHighlight(es *elasticsearch.Client, class string, pageCount int64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassPageCount 以class、page_count为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // pageCount int64 page_count
@@ -183,6 +318,19 @@ func MatchBooksByNameFilterClassPageCount(es *elasticsearch.Client, class string
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassPageCountHighlight 与MatchBooksByNameFilterClassPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassPageCountHighlight(es *elasticsearch.Client, class string, pageCount int64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassPrice 以class、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // price float64 价格
@@ -199,6 +347,19 @@ func MatchBooksByAuthorFilterClassPrice(es *elasticsearch.Client, class string,
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassPriceHighlight 与MatchBooksByAuthorFilterClassPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassPriceHighlight(es *elasticsearch.Client, class string, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassPrice 以class、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // price float64 价格
@@ -215,6 +376,19 @@ func MatchBooksByNameFilterClassPrice(es *elasticsearch.Client, class string, pr
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassPriceHighlight 与MatchBooksByNameFilterClassPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassPriceHighlight(es *elasticsearch.Client, class string, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterSeqPageCount 以seq、page_count为过滤条件对author进行检索查询books的详细数据列表和总数量
 // seq string seq
 // pageCount int64 page_count
@@ -231,6 +405,19 @@ func MatchBooksByAuthorFilterSeqPageCount(es *elasticsearch.Client, seq string,
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterSeqPageCountHighlight 与MatchBooksByAuthorFilterSeqPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterSeqPageCountHighlight(es *elasticsearch.Client, seq string, pageCount int64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterSeqPageCount 以seq、page_count为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // seq string seq
 // pageCount int64 page_count
@@ -247,6 +434,19 @@ func MatchBooksByNameFilterSeqPageCount(es *elasticsearch.Client, seq string, pa
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterSeqPageCountHighlight 与MatchBooksByNameFilterSeqPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterSeqPageCountHighlight(es *elasticsearch.Client, seq string, pageCount int64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterSeqPrice 以seq、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // seq string seq
 // price float64 价格
@@ -263,6 +463,19 @@ func MatchBooksByAuthorFilterSeqPrice(es *elasticsearch.Client, seq string, pric
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterSeqPriceHighlight 与MatchBooksByAuthorFilterSeqPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterSeqPriceHighlight(es *elasticsearch.Client, seq string, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterSeqPrice 以seq、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // seq string seq
 // price float64 价格
@@ -279,6 +492,19 @@ func MatchBooksByNameFilterSeqPrice(es *elasticsearch.Client, seq string, price
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterSeqPriceHighlight 与MatchBooksByNameFilterSeqPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterSeqPriceHighlight(es *elasticsearch.Client, seq string, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterPageCountPrice 以page_count、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // pageCount int64 page_count
 // price float64 价格
@@ -295,6 +521,19 @@ func MatchBooksByAuthorFilterPageCountPrice(es *elasticsearch.Client, pageCount
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterPageCountPriceHighlight 与MatchBooksByAuthorFilterPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterPageCountPriceHighlight(es *elasticsearch.Client, pageCount int64, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterPageCountPrice 以page_count、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // pageCount int64 page_count
 // price float64 价格
@@ -311,6 +550,19 @@ func MatchBooksByNameFilterPageCountPrice(es *elasticsearch.Client, pageCount in
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterPageCountPriceHighlight 与MatchBooksByNameFilterPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterPageCountPriceHighlight(es *elasticsearch.Client, pageCount int64, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassSeqPageCount 以class、seq、page_count为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -329,6 +581,20 @@ func MatchBooksByAuthorFilterClassSeqPageCount(es *elasticsearch.Client, class s
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassSeqPageCountHighlight 与MatchBooksByAuthorFilterClassSeqPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassSeqPageCountHighlight(es *elasticsearch.Client, class string, seq string, pageCount int64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassSeqPageCount 以class、seq、page_count为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -347,6 +613,20 @@ func MatchBooksByNameFilterClassSeqPageCount(es *elasticsearch.Client, class str
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassSeqPageCountHighlight 与MatchBooksByNameFilterClassSeqPageCount的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassSeqPageCountHighlight(es *elasticsearch.Client, class string, seq string, pageCount int64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassSeqPrice 以class、seq、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -365,6 +645,20 @@ func MatchBooksByAuthorFilterClassSeqPrice(es *elasticsearch.Client, class strin
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassSeqPriceHighlight 与MatchBooksByAuthorFilterClassSeqPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassSeqPriceHighlight(es *elasticsearch.Client, class string, seq string, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassSeqPrice 以class、seq、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -383,6 +677,20 @@ func MatchBooksByNameFilterClassSeqPrice(es *elasticsearch.Client, class string,
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassSeqPriceHighlight 与MatchBooksByNameFilterClassSeqPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassSeqPriceHighlight(es *elasticsearch.Client, class string, seq string, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassPageCountPrice 以class、page_count、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // pageCount int64 page_count
@@ -401,6 +709,20 @@ func MatchBooksByAuthorFilterClassPageCountPrice(es *elasticsearch.Client, class
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassPageCountPriceHighlight 与MatchBooksByAuthorFilterClassPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassPageCountPriceHighlight(es *elasticsearch.Client, class string, pageCount int64, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassPageCountPrice 以class、page_count、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // pageCount int64 page_count
@@ -419,6 +741,20 @@ func MatchBooksByNameFilterClassPageCountPrice(es *elasticsearch.Client, class s
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterClassPageCountPriceHighlight 与MatchBooksByNameFilterClassPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassPageCountPriceHighlight(es *elasticsearch.Client, class string, pageCount int64, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterSeqPageCountPrice 以seq、page_count、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // seq string seq
 // pageCount int64 page_count
@@ -437,6 +773,20 @@ func MatchBooksByAuthorFilterSeqPageCountPrice(es *elasticsearch.Client, seq str
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterSeqPageCountPriceHighlight 与MatchBooksByAuthorFilterSeqPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterSeqPageCountPriceHighlight(es *elasticsearch.Client, seq string, pageCount int64, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterSeqPageCountPrice 以seq、page_count、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // seq string seq
 // pageCount int64 page_count
@@ -455,6 +805,20 @@ func MatchBooksByNameFilterSeqPageCountPrice(es *elasticsearch.Client, seq strin
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByNameFilterSeqPageCountPriceHighlight 与MatchBooksByNameFilterSeqPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterSeqPageCountPriceHighlight(es *elasticsearch.Client, seq string, pageCount int64, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
+
 // MatchBooksByAuthorFilterClassSeqPageCountPrice 以class、seq、page_count、价格为过滤条件对author进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -475,6 +839,21 @@ func MatchBooksByAuthorFilterClassSeqPageCountPrice(es *elasticsearch.Client, cl
 	return queryBooksList(es, esQuery)
 }
 
+// MatchBooksByAuthorFilterClassSeqPageCountPriceHighlight 与MatchBooksByAuthorFilterClassSeqPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByAuthorFilterClassSeqPageCountPriceHighlight(es *elasticsearch.Client, class string, seq string, pageCount int64, price float64, author string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("author", author),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"author"})
+}
+
 // MatchBooksByNameFilterClassSeqPageCountPrice 以class、seq、page_count、价格为过滤条件对书名进行检索查询books的详细数据列表和总数量
 // class string class
 // seq string seq
@@ -494,3 +873,18 @@ func MatchBooksByNameFilterClassSeqPageCountPrice(es *elasticsearch.Client, clas
 	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
 	return queryBooksList(es, esQuery)
 }
+
+// MatchBooksByNameFilterClassSeqPageCountPriceHighlight 与MatchBooksByNameFilterClassSeqPageCountPrice的检索条件相同，同时返回每条数据命中字段的高亮片段
+func MatchBooksByNameFilterClassSeqPageCountPriceHighlight(es *elasticsearch.Client, class string, seq string, pageCount int64, price float64, name string) (*BooksHighlightData, *eq.Query, error) {
+	filters := []eq.Map{
+		eq.Term("class", class),
+		eq.Term("seq", seq),
+		eq.Term("page_count", pageCount),
+		eq.Term("price", price),
+	}
+	matches := []eq.Map{
+		eq.Match("name", name),
+	}
+	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(filters), eq.WithMust(matches))}
+	return queryBooksHighlight(es, esQuery, []string{"name"})
+}
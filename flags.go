@@ -0,0 +1,261 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gen "github.com/kyle-hy/es2go/generator"
+)
+
+// mappingFlags 各子命令共用的参数：mapping、映射配置、模板、生成规格、输出布局和配置文件
+type mappingFlags struct {
+	fs *flag.FlagSet
+
+	// required arguments
+	inputPath   *string
+	outputPath  *string
+	packageName *string
+	structName  *string
+
+	// optional arguments
+	initClassName      *string
+	typeMappingPath    *string
+	exceptionFieldPath *string
+	exceptionTypePath  *string
+	skipFieldPath      *string
+	fieldCommentPath   *string
+	tmplPath           *string
+	tmplDir            *string
+	specPath           *string
+	statsPath          *string
+	budget             *int
+	budgetMode         *string
+	outDir             *string
+	filePattern        *string
+	splitPackages      *bool
+	modelImport        *string
+	maxFuncsPerFile    *int
+	doc                *bool
+	configPath         *string
+	indexName          *string
+
+	explicit map[string]bool // 命令行显式指定的参数
+	manifest bool            // 任务来自批量生成的配置文件
+}
+
+// newMappingFlags 在参数集中注册共用的参数
+func newMappingFlags(fs *flag.FlagSet) *mappingFlags {
+	return &mappingFlags{
+		fs:          fs,
+		inputPath:   fs.String("in", "", "Input JSON schema file (including file name)"),
+		outputPath:  fs.String("out", "", "Output Go file (including file name)"),
+		packageName: fs.String("package", "model", "Name of the Go package"),
+		structName:  fs.String("struct", "GeneratedStruct", "Name of the generated Go struct"),
+
+		initClassName:      fs.String("init", "", "Name of the initial wrapper struct (optional)"),
+		typeMappingPath:    fs.String("type-mapping", "", "Path to JSON file specifying Elasticsearch to Go type mapping"),
+		exceptionFieldPath: fs.String("exception-field", "", "Path to JSON file specifying exceptions for field names"),
+		exceptionTypePath:  fs.String("exception-type", "", "Path to JSON file specifying exceptions for field types"),
+		skipFieldPath:      fs.String("skip-field", "", "Path to JSON file specifying fields to skip"),
+		fieldCommentPath:   fs.String("field-comment", "", "Path to JSON file specifying comments for fields"),
+		tmplPath:           fs.String("tmpl", "", "Path to custom Go template file"),
+		tmplDir:            fs.String("tmpl-dir", "", "Directory with templates overriding the built-in ones (struct.tmpl, common.tmpl, detail.tmpl, agg.tmpl, <family>.tmpl)"),
+		specPath:           fs.String("spec", "", "Path to YAML/JSON spec file controlling which query families and field combinations are generated"),
+		statsPath:          fs.String("stats", "", "Path to JSON file with distinct value counts per field, used to rank fields"),
+		budget:             fs.Int("budget", 0, "Maximum number of query functions to generate, 0 means unlimited"),
+		budgetMode:         fs.String("budget-mode", gen.BudgetAbort, "What to do when the budget is exceeded: abort or sample"),
+		outDir:             fs.String("out-dir", "", "Directory for the generated files, defaults to the directory of --out"),
+		filePattern:        fs.String("file-pattern", gen.DefaultFilePattern, "File naming pattern with {model}, {family} and {suffix} placeholders"),
+		splitPackages:      fs.Bool("split-packages", false, "Write the struct to a model/ subdirectory and the queries to a query/ subdirectory"),
+		modelImport:        fs.String("model-import", "", "Import path of the model/ subdirectory, inferred from go.mod when empty"),
+		maxFuncsPerFile:    fs.Int("max-funcs-per-file", 0, "Split query files with more functions into numbered shards, 0 means no splitting"),
+		doc:                fs.Bool("doc", false, "Generate a doc.go listing the generated files in each output directory"),
+		configPath:         fs.String("config", "", "Path to es2go.yaml (or .json) holding mapping overrides and generator settings per index"),
+		indexName:          fs.String("index", "", "Index of --config to generate, may be omitted when the config has a single index"),
+	}
+}
+
+// parse 解析命令行参数并记录显式指定的参数
+func (f *mappingFlags) parse(args []string) {
+	parseFlags(f.fs, args)
+	f.explicit = map[string]bool{}
+	f.fs.Visit(func(fl *flag.Flag) { f.explicit[fl.Name] = true })
+}
+
+// useFlag 使用配置文件时只有显式指定的参数覆盖配置
+func (f *mappingFlags) useFlag(name string) bool {
+	return (*f.configPath == "" && !f.manifest) || f.explicit[name]
+}
+
+// applyFlags 将命令行参数设置到生成选项
+func (f *mappingFlags) applyFlags(opts *gen.GenOptions) {
+	if opts.Layout == nil {
+		opts.Layout = &gen.Layout{}
+	}
+	opts.TypeMappingPath = nullableString(f.typeMappingPath)
+	opts.ExceptionFieldPath = nullableString(f.exceptionFieldPath)
+	opts.ExceptionTypePath = nullableString(f.exceptionTypePath)
+	opts.SkipFieldPath = nullableString(f.skipFieldPath)
+	opts.FieldCommentPath = nullableString(f.fieldCommentPath)
+	if f.useFlag("init") {
+		opts.InitClassName = nullableString(f.initClassName)
+	}
+	if f.useFlag("tmpl") {
+		opts.TmplPath = nullableString(f.tmplPath)
+	}
+	if f.useFlag("tmpl-dir") {
+		opts.TmplDir = nullableString(f.tmplDir)
+	}
+	if f.useFlag("spec") {
+		opts.SpecPath = nullableString(f.specPath)
+	}
+	if f.useFlag("stats") {
+		opts.StatsPath = nullableString(f.statsPath)
+	}
+	if f.useFlag("out-dir") {
+		opts.Layout.OutDir = *f.outDir
+	}
+	if f.useFlag("file-pattern") {
+		opts.Layout.FilePattern = *f.filePattern
+	}
+	if f.useFlag("split-packages") {
+		opts.Layout.SplitPackages = *f.splitPackages
+	}
+	if f.useFlag("model-import") {
+		opts.Layout.ModelImport = *f.modelImport
+	}
+	if f.useFlag("max-funcs-per-file") {
+		opts.Layout.MaxFuncsPerFile = *f.maxFuncsPerFile
+	}
+	if f.useFlag("doc") {
+		opts.Layout.Doc = *f.doc
+	}
+}
+
+// loadJob 加载单个索引的生成任务，指定配置文件时以配置为基础
+func (f *mappingFlags) loadJob() (*gen.BatchJob, error) {
+	job := &gen.BatchJob{InputPath: *f.inputPath, OutputPath: *f.outputPath, PackageName: *f.packageName, StructName: *f.structName, Options: &gen.GenOptions{}}
+	if *f.configPath != "" {
+		idx, err := loadIndexConfig(*f.configPath, *f.indexName)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load config: %v", err)
+		}
+		job.Options = idx.Options()
+		if !f.explicit["in"] {
+			job.InputPath = idx.In
+		}
+		if !f.explicit["out"] {
+			job.OutputPath = idx.Out
+		}
+		if !f.explicit["package"] {
+			job.PackageName = idx.Package
+		}
+		if !f.explicit["struct"] {
+			job.StructName = idx.Struct
+		}
+	}
+	job.Name = job.StructName
+
+	// validate required arguments
+	if job.InputPath == "" || job.OutputPath == "" || job.StructName == "" || job.PackageName == "" {
+		return nil, fmt.Errorf("All --in, --out, --struct, and --package must be specified")
+	}
+
+	// set up generator options
+	f.applyFlags(job.Options)
+	return job, nil
+}
+
+// loadBatchJobs 从配置文件或mapping文件的目录加载批量生成任务，目录方式需要指定--out-dir
+func (f *mappingFlags) loadBatchJobs(batch string) ([]*gen.BatchJob, error) {
+	f.manifest = isManifest(batch)
+	var jobs []*gen.BatchJob
+	if f.manifest {
+		cfg, err := gen.LoadConfigFile(batch)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load batch jobs: %v", err)
+		}
+		jobs, err = gen.BatchJobsFromConfig(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load batch jobs: %v", err)
+		}
+	} else {
+		if *f.outDir == "" {
+			return nil, fmt.Errorf("--out-dir must be specified when --batch is a directory or glob")
+		}
+		var err error
+		jobs, err = gen.BatchJobsFromGlob(batch, *f.outDir, *f.packageName)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load batch jobs: %v", err)
+		}
+	}
+
+	for _, job := range jobs {
+		if job.Options == nil {
+			job.Options = &gen.GenOptions{}
+		}
+		f.applyFlags(job.Options)
+		if f.explicit["package"] {
+			job.PackageName = *f.packageName
+		}
+	}
+	return jobs, nil
+}
+
+// loadModel 加载单个索引的任务并解析mapping，不写入文件，按--budget检查函数数量
+func (f *mappingFlags) loadModel() (*gen.BatchJob, *gen.EsModelInfo, error) {
+	job, err := f.loadJob()
+	if err != nil {
+		return nil, nil, err
+	}
+	job.Options.SkipWrite = true
+	esInfo, err := gen.GenEsModel(job.InputPath, job.OutputPath, job.PackageName, job.StructName, job.Options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to generate data model: %v", err)
+	}
+	err = gen.ApplyBudget(esInfo, gen.PlanFuncs(esInfo), *f.budget, *f.budgetMode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Budget exceeded: %v", err)
+	}
+	return job, esInfo, nil
+}
+
+// loadIndexConfig 加载配置文件中指定索引的配置，配置只有一个索引时可以不指定
+func loadIndexConfig(configPath, indexName string) (*gen.IndexConfig, error) {
+	cfg, err := gen.LoadConfigFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if indexName == "" {
+		names := cfg.IndexNames()
+		if len(names) != 1 {
+			return nil, fmt.Errorf("config %s has %d indices, specify one with --index: %s", configPath, len(names), strings.Join(names, ", "))
+		}
+		indexName = names[0]
+	}
+	return cfg.Index(indexName)
+}
+
+// isManifest 判断--batch参数是否为配置文件，否则为mapping文件的目录或通配符
+func isManifest(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	case ".json":
+		// 单个json文件也可能是mapping文件，存在且不含通配符时按配置文件处理
+		info, err := os.Stat(path)
+		return err == nil && !info.IsDir()
+	}
+	return false
+}
+
+// nullableString is a helper function to treat flag.String values as nullable.
+func nullableString(flagValue *string) *string {
+	if *flagValue == "" {
+		return nil
+	}
+	return flagValue
+}
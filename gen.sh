@@ -1,4 +1,4 @@
-go run main.go \
+go run . \
     --in example/elasticsearch/books.json \
     --out example/model/books.go \
     --struct Books \
@@ -1,4 +1,4 @@
-go run main.go \
+go run . \
     --in example/elasticsearch/products-mapping.json \
     --out example/model/products.go \
     --struct Products --package model
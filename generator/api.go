@@ -0,0 +1,189 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 以库的方式使用es2go：输入mapping的内容，返回生成的代码，不读写文件、不执行外部命令，只在指定Logger时输出日志，
+// 可在服务中按需生成代码。需要加载配置文件或写入文件时使用GenEsModel和RenderAll
+
+// MappingOptions 解析mapping的选项，均可为空
+type MappingOptions struct {
+	IndexName     string       // es的索引(表)名称，为空时取自GET <index>/_mapping的响应
+	PackageName   string       // go的包名，为空时使用model
+	StructName    string       // go的模型结构体名称，为空时由索引名称转换为大驼峰
+	InitClassName string       // go自定义封装的类型名称，为空时不生成封装类型
+	Config        *Config      // 映射配置，为空时使用默认的类型映射
+	Spec          *GenSpec     // 生成规格，为空时按默认规则生成
+	Stats         FieldStats   // 字段的去重值数量，用于字段重要性评分
+	Layout        *Layout      // 输出文件的布局，拆分目录时必须指定ModelImport
+	Logger        *slog.Logger // 生成过程的日志，为空时不输出
+}
+
+// ParseMapping 解析GET <index>/_mapping的响应内容({"books": {"mappings": ...}})，索引名称取自响应，
+// 包名为model，结构体名称为索引名称的大驼峰形式，其他选项使用默认值。
+// 只包含mapping本身({"mappings": ...})的内容没有索引名称，需要使用ParseMappingWithOptions指定
+func ParseMapping(data []byte) (*EsModelInfo, error) {
+	return ParseMappingWithOptions(data, MappingOptions{})
+}
+
+// ParseMappingWithOptions 按选项解析mapping的内容，返回生成代码所需的模型信息。
+// 内容可以是mapping本身或GET <index>/_mapping的响应，IndexName为空时取自响应中的索引名称。
+// 生成文件的路径相对于布局的输出目录，模型文件以索引名称命名
+func ParseMappingWithOptions(data []byte, opts MappingOptions) (*EsModelInfo, error) {
+	name, data, err := unwrapMapping(data)
+	if err != nil {
+		return nil, err
+	}
+	if opts.IndexName == "" {
+		opts.IndexName = name
+	}
+	if opts.IndexName == "" {
+		return nil, fmt.Errorf("index name must be specified for a mapping without the index name")
+	}
+	packageName := opts.PackageName
+	if packageName == "" {
+		packageName = "model"
+	}
+	structName := opts.StructName
+	if structName == "" {
+		structName = utils.ToPascalCase(strings.NewReplacer("-", "_", ".", "_").Replace(opts.IndexName))
+	}
+	cfg, err := newConfig(&GenOptions{Config: opts.Config})
+	if err != nil {
+		return nil, err
+	}
+
+	// 输出文件的布局，不根据go.mod推断导入路径
+	layout := &Layout{}
+	if opts.Layout != nil {
+		*layout = *opts.Layout
+	}
+	err = layout.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if layout.SplitPackages && layout.ModelImport == "" {
+		return nil, fmt.Errorf("model import path must be specified when splitting packages")
+	}
+
+	esInfo, err := parseMappingData("mapping of "+opts.IndexName, data, opts.IndexName, packageName, structName, opts.InitClassName, cfg)
+	if err != nil {
+		return nil, err
+	}
+	esInfo.Layout = layout
+	if opts.Logger != nil {
+		esInfo.Logger = opts.Logger.With("model", structName)
+	}
+	esInfo.ModelPath = layout.ModelPath(apiOutputPath(esInfo))
+	if opts.Spec != nil {
+		// 预算可能修改max_funcs，复制后使用以免影响调用方
+		esInfo.Spec = opts.Spec.clone()
+	}
+
+	// 字段重要性评分
+	err = RankFields(esInfo.Fields, opts.Stats)
+	if err != nil {
+		return nil, err
+	}
+	return esInfo, nil
+}
+
+// unwrapMapping 从GET <index>/_mapping的响应中取出索引名称和mapping，内容为mapping本身时索引名称为空
+func unwrapMapping(data []byte) (string, []byte, error) {
+	var indices map[string]json.RawMessage
+	if json.Unmarshal(data, &indices) != nil {
+		return "", data, nil // 由解析mapping时报告错误的位置
+	}
+	if _, ok := indices["mappings"]; ok {
+		return "", data, nil
+	}
+	if len(indices) != 1 {
+		return "", nil, fmt.Errorf("mapping response contains %d indices, expected a single index", len(indices))
+	}
+	for name, mapping := range indices {
+		return name, mapping, nil
+	}
+	return "", data, nil
+}
+
+// inMemory 复制模型信息用于库方式渲染，只使用内置模板，格式化时不查找缺少的导入
+func inMemory(esInfo *EsModelInfo) (*EsModelInfo, error) {
+	if esInfo.TmplDir != "" {
+		return nil, fmt.Errorf("template directory %s is not supported when rendering in memory, use RenderAll for custom templates", esInfo.TmplDir)
+	}
+	info := *esInfo
+	info.formatOnly = true
+	return &info, nil
+}
+
+// RenderModel 渲染模型结构体的代码，返回格式化后的代码
+func RenderModel(esInfo *EsModelInfo) ([]byte, error) {
+	if esInfo.StructDefinitions == "" {
+		return nil, fmt.Errorf("model info of %s has no struct definitions, parse the mapping first", esInfo.StructName)
+	}
+	esInfo, err := inMemory(esInfo)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := loadTemplate("structWithoutWrapper", esInfo.TmplDir, []string{"struct.tmpl"}, StructTplWithoutWrapper)
+	if esInfo.InitClassName != "" {
+		tmpl, err = loadTemplate("structWithWrapper", esInfo.TmplDir, []string{"struct_wrapper.tmpl"}, StructTplWithWrapper)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return renderModel(esInfo, tmpl)
+}
+
+// RenderQueries 渲染通用辅助函数和指定查询类型的查询函数，families为空时渲染全部已注册的查询类型，
+// 返回文件路径 -> 格式化后的代码，文件以索引名称命名。渲染失败的查询类型不在结果中，错误合并后返回
+func RenderQueries(esInfo *EsModelInfo, families []string) (map[string][]byte, error) {
+	for _, family := range families {
+		if Lookup(family) == nil {
+			return nil, fmt.Errorf("unknown query family %q", family)
+		}
+	}
+
+	esInfo, err := inMemory(esInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	outputPath := apiOutputPath(esInfo)
+	files := map[string][]byte{}
+	errs := []error{}
+	common, err := RenderCommon(outputPath, esInfo)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		files[common.Path] = common.Source
+	}
+
+	for _, g := range Generators() {
+		if len(families) > 0 && !slices.Contains(families, g.Name()) {
+			continue
+		}
+		rendered, err := Render(g, outputPath, esInfo)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, f := range rendered {
+			files[f.Path] = f.Source
+		}
+	}
+	return files, errors.Join(errs...)
+}
+
+// apiOutputPath 库方式生成时模型文件的名称，由索引名称确定，生成的文件路径都相对于布局的输出目录
+func apiOutputPath(esInfo *EsModelInfo) string {
+	return esInfo.IndexName + ".go"
+}
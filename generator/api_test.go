@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRenderInMemory(t *testing.T) {
+	mapping, err := os.ReadFile(filepath.Join("..", "example", "elasticsearch", "books.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 在空的工作目录中生成，结束后目录仍为空
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	layouts := map[string]*Layout{
+		"default": nil,
+		"split":   {OutDir: "gen", SplitPackages: true, ModelImport: "example.com/gen/model", MaxFuncsPerFile: 20, Doc: true},
+	}
+	for name, layout := range layouts {
+		esInfo, err := ParseMappingWithOptions(mapping, MappingOptions{IndexName: "books", Layout: layout})
+		if err != nil {
+			t.Fatalf("%s: ParseMappingWithOptions: %v", name, err)
+		}
+		if esInfo.StructName != "Books" || esInfo.PackageName != "model" {
+			t.Errorf("%s: default struct %s in package %s, want Books in model", name, esInfo.StructName, esInfo.PackageName)
+		}
+
+		model, err := RenderModel(esInfo)
+		if err != nil {
+			t.Fatalf("%s: RenderModel: %v", name, err)
+		}
+		if !bytes.Contains(model, []byte("type Books struct")) {
+			t.Errorf("%s: model source has no Books struct:\n%s", name, model)
+		}
+
+		files, err := RenderQueries(esInfo, nil)
+		if err != nil {
+			t.Fatalf("%s: RenderQueries: %v", name, err)
+		}
+		if _, ok := files["books_common.go"]; !ok && layout == nil {
+			t.Errorf("%s: books_common.go is not rendered", name)
+		}
+		for path, src := range files {
+			if filepath.IsAbs(path) || len(src) == 0 {
+				t.Errorf("%s: file %s is absolute or empty", name, path)
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		t.Errorf("rendering in memory created %s", e.Name())
+	}
+}
+
+func TestParseMapping(t *testing.T) {
+	mapping, err := os.ReadFile(filepath.Join("..", "example", "elasticsearch", "books.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// GET <index>/_mapping的响应包含索引名称
+	response := append(append([]byte(`{"book-store": `), mapping...), '}')
+	esInfo, err := ParseMapping(response)
+	if err != nil {
+		t.Fatalf("ParseMapping: %v", err)
+	}
+	if esInfo.IndexName != "book-store" || esInfo.StructName != "BookStore" || esInfo.PackageName != "model" {
+		t.Errorf("ParseMapping = index %s, struct %s, package %s, want book-store, BookStore, model", esInfo.IndexName, esInfo.StructName, esInfo.PackageName)
+	}
+	if len(esInfo.Fields) == 0 {
+		t.Error("ParseMapping found no fields in the response")
+	}
+
+	// mapping本身没有索引名称
+	_, err = ParseMapping(mapping)
+	if err == nil {
+		t.Error("ParseMapping of a mapping without the index name returned nil")
+	}
+	_, err = ParseMappingWithOptions([]byte(`{"mappings": {"properties": {}}}`), MappingOptions{})
+	if err == nil {
+		t.Error("ParseMappingWithOptions without an index name returned nil")
+	}
+	_, err = ParseMapping([]byte(`{"a": {"mappings": {}}, "b": {"mappings": {}}}`))
+	if err == nil {
+		t.Error("ParseMapping of a response with two indices returned nil")
+	}
+}
+
+func TestRenderInMemoryRejectsTmplDir(t *testing.T) {
+	esInfo, err := ParseMappingWithOptions([]byte(`{"mappings": {"properties": {"name": {"type": "keyword"}}}}`), MappingOptions{IndexName: "names"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	esInfo.TmplDir = t.TempDir()
+	if _, err := RenderModel(esInfo); err == nil {
+		t.Error("RenderModel with a template directory returned nil")
+	}
+	if _, err := RenderQueries(esInfo, nil); err == nil {
+		t.Error("RenderQueries with a template directory returned nil")
+	}
+}
+
+func TestFormatSourceFormatOnly(t *testing.T) {
+	// 只格式化时不查找缺少的导入
+	src := []byte("package p\nfunc f() { fmt.Println() }\n")
+	out, err := formatSource("test", "p.go", src, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(out, []byte(`"fmt"`)) {
+		t.Errorf("formatSource resolved a missing import in format only mode:\n%s", out)
+	}
+}
@@ -0,0 +1,327 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 批量生成：使用worker pool并发渲染多个索引的代码，检查同一个包中的命名冲突后再统一写入
+
+// BatchJob 批量生成中一个索引的生成任务
+type BatchJob struct {
+	Name        string      // 索引名称，用于报告
+	InputPath   string      // es mapping文件
+	OutputPath  string      // 模型结构体文件
+	PackageName string      // 代码包名
+	StructName  string      // 模型结构体名称
+	Options     *GenOptions // 生成选项
+}
+
+// BatchJobsFromConfig 配置文件中的每个索引作为一个生成任务，按索引名称排序
+func BatchJobsFromConfig(cfg *FileConfig) ([]*BatchJob, error) {
+	jobs := []*BatchJob{}
+	for _, name := range cfg.IndexNames() {
+		idx, err := cfg.Index(name)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, &BatchJob{
+			Name:        name,
+			InputPath:   idx.In,
+			OutputPath:  idx.Out,
+			PackageName: idx.Package,
+			StructName:  idx.Struct,
+			Options:     idx.Options(),
+		})
+	}
+	return jobs, nil
+}
+
+// BatchJobsFromGlob 匹配的每个mapping文件作为一个生成任务，pattern为目录时匹配目录中的*.json。
+// 索引名称为去掉_mapping或-mapping后缀的文件名，结构体名称为索引名称的PascalCase形式(-和.视为_)，
+// 模型文件输出到outDir中以索引名称命名的文件，生成选项由调用方设置
+func BatchJobsFromGlob(pattern, outDir, packageName string) ([]*BatchJob, error) {
+	if info, err := os.Stat(pattern); err == nil && info.IsDir() {
+		pattern = filepath.Join(pattern, "*.json")
+	}
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to match mapping files %s: %v", pattern, err)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no mapping files match %s", pattern)
+	}
+
+	jobs := []*BatchJob{}
+	inputs := map[string]string{}
+	for _, input := range matches {
+		name := strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+		for _, suffix := range []string{"_mapping", "-mapping"} {
+			name = strings.TrimSuffix(name, suffix)
+		}
+		if prev, ok := inputs[name]; ok {
+			return nil, fmt.Errorf("mapping files %s and %s are both named index %s", prev, input, name)
+		}
+		inputs[name] = input
+		jobs = append(jobs, &BatchJob{
+			Name:        name,
+			InputPath:   input,
+			OutputPath:  filepath.Join(outDir, name+".go"),
+			PackageName: packageName,
+			StructName:  utils.ToPascalCase(strings.NewReplacer("-", "_", ".", "_").Replace(name)),
+		})
+	}
+	return jobs, nil
+}
+
+// BatchOptions 批量生成的选项
+type BatchOptions struct {
+	Workers    int    // 并发渲染的数量，小于1时为1
+	Budget     int    // 每个索引生成函数总数的上限，0表示不限制
+	BudgetMode string // 超出预算时的处理方式
+	Force      bool   // 忽略清单，重新渲染输入未变化的索引
+}
+
+// BatchResult 一个索引的渲染结果
+type BatchResult struct {
+	Job   *BatchJob      // 生成任务
+	Info  *EsModelInfo   // 解析mapping得到的模型信息，解析失败时为空
+	Files []*OutputFile  // 渲染的代码文件
+	Funcs map[string]int // 各查询类型生成的函数数量
+	Hash  string         // 输入摘要
+	Fresh bool           // 输入未变化，Files为磁盘上已有的文件
+	Err   error          // 渲染错误
+}
+
+// TotalFuncs 生成的查询函数总数
+func (r *BatchResult) TotalFuncs() int {
+	total := 0
+	for _, n := range r.Funcs {
+		total += n
+	}
+	return total
+}
+
+// RenderBatch 使用worker pool并发渲染各索引的代码，不写入文件，结果与jobs的顺序一致。
+// doc.go依赖同一目录中其他索引的文件，批量渲染时不生成，写入后通过WriteBatchDocs生成
+func RenderBatch(jobs []*BatchJob, opts BatchOptions) []*BatchResult {
+	results := make([]*BatchResult, len(jobs))
+	ch := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(opts.Workers, 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ch {
+				results[i] = renderJob(jobs[i], opts)
+			}
+		}()
+	}
+	for i := range jobs {
+		ch <- i
+	}
+	close(ch)
+	wg.Wait()
+	return results
+}
+
+// renderJob 渲染一个索引的代码并统计各查询类型的函数数量，输入未变化时使用磁盘上已有的文件
+func renderJob(job *BatchJob, opts BatchOptions) *BatchResult {
+	result := &BatchResult{Job: job, Funcs: map[string]int{}}
+
+	genOpts := &GenOptions{}
+	if job.Options != nil {
+		*genOpts = *job.Options
+	}
+	genOpts.SkipWrite = true
+	if genOpts.Layout != nil {
+		layout := *genOpts.Layout
+		layout.Doc = false
+		genOpts.Layout = &layout
+	}
+
+	esInfo, err := GenEsModel(job.InputPath, job.OutputPath, job.PackageName, job.StructName, genOpts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Info = esInfo
+
+	err = ApplyBudget(esInfo, PlanFuncs(esInfo), opts.Budget, opts.BudgetMode)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Hash, err = InputHash(esInfo)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if !opts.Force {
+		result.Files, result.Err = LoadUpToDate(job.OutputPath, esInfo, result.Hash)
+		result.Fresh = result.Files != nil
+	}
+	if !result.Fresh && result.Err == nil {
+		result.Files, result.Err = RenderAll(job.OutputPath, esInfo)
+	}
+	for _, f := range result.Files {
+		if f.Generator == "model" || f.Generator == "common" {
+			continue
+		}
+		decls, err := parseDecls(f.Path, f.Source)
+		if err == nil {
+			result.Funcs[f.Generator] += len(decls.Funcs)
+		}
+	}
+	return result
+}
+
+// Collision 输出到同一目录(同一个包)的多个索引定义了同名的标识符或输出到同一个文件
+type Collision struct {
+	Dir     string   // 输出目录
+	Name    string   // 冲突的类型、函数或文件名
+	Kind    string   // type、func或file
+	Indices []string // 冲突的索引名称
+}
+
+func (c *Collision) String() string {
+	return fmt.Sprintf("%s %s in %s is generated by %s", c.Kind, c.Name, c.Dir, strings.Join(c.Indices, ", "))
+}
+
+// FindCollisions 检查输出到同一目录的各索引是否定义了同名的类型或函数，或者输出到同一个文件，
+// 如两个索引使用了相同的结构体名称，或者嵌套对象生成了同名的结构体
+func FindCollisions(results []*BatchResult) []*Collision {
+	owners := map[[3]string][]string{} // [目录, 类型, 名称] -> 索引名称
+	for _, r := range results {
+		seen := map[[3]string]bool{}
+		add := func(key [3]string) {
+			if !seen[key] {
+				seen[key] = true
+				owners[key] = append(owners[key], r.Job.Name)
+			}
+		}
+		for _, f := range r.Files {
+			dir := filepath.Clean(filepath.Dir(f.Path))
+			add([3]string{dir, "file", filepath.Base(f.Path)})
+			decls, err := parseDecls(f.Path, f.Source)
+			if err != nil {
+				continue
+			}
+			for _, name := range decls.Types {
+				add([3]string{dir, "type", name})
+			}
+			for _, name := range decls.Funcs {
+				add([3]string{dir, "func", name})
+			}
+		}
+	}
+
+	collisions := []*Collision{}
+	for key, indices := range owners {
+		if len(indices) > 1 {
+			sort.Strings(indices)
+			collisions = append(collisions, &Collision{Dir: key[0], Kind: key[1], Name: key[2], Indices: indices})
+		}
+	}
+	sort.Slice(collisions, func(i, j int) bool {
+		a, b := collisions[i], collisions[j]
+		if a.Dir != b.Dir {
+			return a.Dir < b.Dir
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return collisions
+}
+
+// WriteBatchDocs 为启用doc.go的索引在各输出目录生成doc.go，同一目录只生成一次
+func WriteBatchDocs(results []*BatchResult) error {
+	done := map[string]bool{}
+	for _, r := range results {
+		if r.Info == nil || r.Err != nil || r.Job.Options == nil || r.Job.Options.Layout == nil || !r.Job.Options.Layout.Doc {
+			continue
+		}
+		layout := r.Info.Layout
+		key := layout.ModelDir(r.Job.OutputPath) + "\x00" + layout.QueryDir(r.Job.OutputPath)
+		if done[key] {
+			continue
+		}
+		done[key] = true
+
+		err := GenEsDocs(r.Job.OutputPath, r.Info)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintBatchReport 输出各索引生成的文件数量、函数总数和各查询类型的函数数量
+func PrintBatchReport(w io.Writer, results []*BatchResult) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "INDEX\tSTRUCT\tFILES\tFUNCS\tDETAIL")
+	total := 0
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(tw, "%s\t%s\t-\t-\tFAILED: %v\n", r.Job.Name, r.Job.StructName, firstLine(r.Err.Error()))
+			continue
+		}
+		// 按生成器的注册顺序列出各查询类型的函数数量
+		detail := []string{}
+		for _, g := range Generators() {
+			if n, ok := r.Funcs[g.Name()]; ok {
+				detail = append(detail, fmt.Sprintf("%s=%d", g.Name(), n))
+			}
+		}
+		total += r.TotalFuncs()
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n", r.Job.Name, r.Job.StructName, len(r.Files), r.TotalFuncs(), strings.Join(detail, " "))
+	}
+	fmt.Fprintf(tw, "TOTAL\t\t\t%d\t\n", total)
+	tw.Flush()
+}
+
+// batchReportItem JSON格式报告中一个索引的信息
+type batchReportItem struct {
+	Index  string         `json:"index"`
+	Struct string         `json:"struct"`
+	Files  []string       `json:"files"`
+	Funcs  map[string]int `json:"funcs"`
+	Total  int            `json:"total"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// WriteBatchReport 以JSON格式输出各索引的生成结果
+func WriteBatchReport(w io.Writer, results []*BatchResult) error {
+	items := []*batchReportItem{}
+	for _, r := range results {
+		item := &batchReportItem{Index: r.Job.Name, Struct: r.Job.StructName, Files: []string{}, Funcs: r.Funcs, Total: r.TotalFuncs()}
+		for _, f := range r.Files {
+			item.Files = append(item.Files, f.Path)
+		}
+		if r.Err != nil {
+			item.Error = r.Err.Error()
+		}
+		items = append(items, item)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(items)
+}
+
+// firstLine 多行错误信息只保留第一行
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// exampleJobs 示例mapping的批量生成任务，每个mapping重复copies次，输出到临时目录中不同的包
+func exampleJobs(t *testing.T, copies int) []*BatchJob {
+	t.Helper()
+	dir := t.TempDir()
+	jobs := []*BatchJob{}
+	for c := 0; c < copies; c++ {
+		for _, name := range exampleMappings {
+			structName := strings.TrimSuffix(strings.TrimSuffix(name, ".json"), "-mapping")
+			jobs = append(jobs, &BatchJob{
+				Name:        structName,
+				InputPath:   filepath.Join("..", "example", "elasticsearch", name),
+				OutputPath:  filepath.Join(dir, structName, structName+".go"),
+				PackageName: "model",
+				StructName:  "M" + structName,
+			})
+		}
+	}
+	return jobs
+}
+
+// sameFiles 检查两组渲染结果的文件和内容一致
+func sameFiles(t *testing.T, name string, got, want []*OutputFile) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s: rendered %d files, want %d", name, len(got), len(want))
+		return
+	}
+	for i := range want {
+		if got[i].Path != want[i].Path || !bytes.Equal(got[i].Source, want[i].Source) {
+			t.Errorf("%s: file %s differs from the sequential rendering", name, want[i].Path)
+		}
+	}
+}
+
+// testBudget 并发测试中每个索引生成函数的上限，仍包含全部查询类型，减少-race下的耗时
+const testBudget = 100
+
+func TestRenderBatchConcurrent(t *testing.T) {
+	jobs := exampleJobs(t, 2)
+	want := RenderBatch(jobs, BatchOptions{Workers: 1, Budget: testBudget, BudgetMode: BudgetSample, Force: true})
+	got := RenderBatch(jobs, BatchOptions{Workers: 4, Budget: testBudget, BudgetMode: BudgetSample, Force: true})
+	for i, r := range got {
+		if r.Err != nil || want[i].Err != nil {
+			t.Fatalf("%s: render error: %v, %v", r.Job.Name, r.Err, want[i].Err)
+		}
+		sameFiles(t, r.Job.Name, r.Files, want[i].Files)
+		if r.TotalFuncs() != want[i].TotalFuncs() {
+			t.Errorf("%s: %d functions, want %d", r.Job.Name, r.TotalFuncs(), want[i].TotalFuncs())
+		}
+	}
+}
+
+func TestGenEsModelConcurrent(t *testing.T) {
+	jobs := exampleJobs(t, 1)
+	render := func(job *BatchJob) ([]*OutputFile, error) {
+		esInfo, err := GenEsModel(job.InputPath, job.OutputPath, job.PackageName, job.StructName, &GenOptions{SkipWrite: true})
+		if err != nil {
+			return nil, err
+		}
+		err = ApplyBudget(esInfo, PlanFuncs(esInfo), testBudget, BudgetSample)
+		if err != nil {
+			return nil, err
+		}
+		return RenderAll(job.OutputPath, esInfo)
+	}
+
+	want := make([][]*OutputFile, len(jobs))
+	for i, job := range jobs {
+		files, err := render(job)
+		if err != nil {
+			t.Fatalf("%s: %v", job.Name, err)
+		}
+		want[i] = files
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 3; g++ {
+		for i, job := range jobs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				files, err := render(job)
+				if err != nil {
+					t.Errorf("%s: %v", job.Name, err)
+					return
+				}
+				sameFiles(t, job.Name, files, want[i])
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func TestRegisterDuringBatch(t *testing.T) {
+	registryMu.RLock()
+	saved := slices.Clone(generators)
+	registryMu.RUnlock()
+	t.Cleanup(func() {
+		registryMu.Lock()
+		generators = saved
+		registryMu.Unlock()
+	})
+
+	// 批量渲染的同时注册新的生成器和替换已有的生成器
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			name := fmt.Sprintf("race_%d", i%5)
+			Register(NewGenerator(name, "_"+name, func(*EsModelInfo) []*FuncTplData { return nil }, "package {{.PackageName}}\n"))
+			Register(DetailTermGenerator)
+		}
+	}()
+
+	results := RenderBatch(exampleJobs(t, 1), BatchOptions{Workers: 4, Budget: testBudget, BudgetMode: BudgetSample, Force: true})
+	close(stop)
+	<-done
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("%s: render error: %v", r.Job.Name, r.Err)
+		}
+	}
+	if Lookup("race_0") == nil {
+		t.Error("generator registered during the batch is not found")
+	}
+}
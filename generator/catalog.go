@@ -0,0 +1,53 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+// 查询函数目录：列出各查询类型将要生成的函数名称、注释和参数，不渲染代码，
+// 供Chat2BI按接口注释检索预设接口，或在生成前检查函数的命名和描述
+
+// CatalogEntry 目录中的一个查询函数
+type CatalogEntry struct {
+	Family  string `json:"family"`  // 查询类型
+	Name    string `json:"name"`    // 函数名称
+	Comment string `json:"comment"` // 函数注释
+	Params  string `json:"params"`  // 参数列表，不含es客户端
+}
+
+// Catalog 按生成器的注册顺序列出启用的查询类型的函数，families为空时列出全部查询类型
+func Catalog(esInfo *EsModelInfo, families []string) []*CatalogEntry {
+	entries := []*CatalogEntry{}
+	for _, g := range Generators() {
+		if !esInfo.Spec.Enabled(g.Name()) || (len(families) > 0 && !slices.Contains(families, g.Name())) {
+			continue
+		}
+		for _, fd := range g.Prepare(esInfo) {
+			entries = append(entries, &CatalogEntry{Family: g.Name(), Name: fd.Name, Comment: fd.Comment, Params: fd.Params})
+		}
+	}
+	return entries
+}
+
+// WriteCatalog 输出函数目录，format为json时输出JSON数组，为text时输出对齐的表格
+func WriteCatalog(w io.Writer, entries []*CatalogEntry, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "FAMILY\tNAME\tCOMMENT")
+		for _, e := range entries {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", e.Family, e.Name, strings.ReplaceAll(e.Comment, "\n", " "))
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown catalog format %q, use json or text", format)
+}
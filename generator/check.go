@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 比较渲染的代码与磁盘上的文件，用于检查已提交的代码是否过期
+
+// 文件的变化状态
+const (
+	FileCreate    = "create"    // 文件不存在，将会创建
+	FileUpdate    = "update"    // 文件内容不一致，将会覆盖
+	FileUnchanged = "unchanged" // 文件内容一致
+	FileDelete    = "delete"    // 清单中记录的文件不再生成，将会删除
+)
+
+// FileDiff 渲染的代码与磁盘上文件的差异
+type FileDiff struct {
+	Path   string // 文件路径
+	Status string // 文件的变化状态
+	Diff   string // unified diff，内容一致时为空
+}
+
+// CompareFiles 比较渲染的代码与磁盘上的文件，不存在的文件按空文件比较
+func CompareFiles(files []*OutputFile) ([]*FileDiff, error) {
+	diffs := []*FileDiff{}
+	for _, f := range files {
+		old, err := os.ReadFile(f.Path)
+		status := FileUpdate
+		if errors.Is(err, fs.ErrNotExist) {
+			status = FileCreate
+		} else if err != nil {
+			return nil, fmt.Errorf("Failed to read file %s: %v", f.Path, err)
+		}
+
+		diff := utils.UnifiedDiff(f.Path, f.Path+" (generated)", string(old), string(f.Source))
+		if diff == "" && status == FileUpdate {
+			status = FileUnchanged
+		}
+		diffs = append(diffs, &FileDiff{Path: f.Path, Status: status, Diff: diff})
+	}
+	return diffs, nil
+}
+
+// CompareOrphans 比较清单中该模型不再生成的文件，如关闭的查询类型、减少的分片，重新生成时这些文件会被删除。
+// 已不存在的文件不报告
+func CompareOrphans(outputPath string, esInfo *EsModelInfo, files []*OutputFile) ([]*FileDiff, error) {
+	orphans, err := orphanedFiles(outputPath, esInfo, files)
+	if err != nil {
+		return nil, err
+	}
+	diffs := []*FileDiff{}
+	for _, path := range orphans {
+		old, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read file %s: %v", path, err)
+		}
+		diffs = append(diffs, &FileDiff{Path: path, Status: FileDelete, Diff: utils.UnifiedDiff(path, "/dev/null", string(old), "")})
+	}
+	return diffs, nil
+}
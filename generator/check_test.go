@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompareOrphans(t *testing.T) {
+	input := filepath.Join("..", "example", "elasticsearch", "books.json")
+	outputPath := filepath.Join(t.TempDir(), "books.go")
+	render := func(spec *GenSpec) (*EsModelInfo, []*OutputFile) {
+		t.Helper()
+		esInfo, err := GenEsModel(input, outputPath, "model", "Books", &GenOptions{SkipWrite: true, Spec: spec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		files, err := RenderAll(outputPath, esInfo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return esInfo, files
+	}
+
+	// 全部查询类型写入后关闭部分查询类型
+	esInfo, files := render(nil)
+	hash, err := InputHash(esInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = WriteIncremental(outputPath, esInfo, hash, files, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orphans, err := CompareOrphans(outputPath, esInfo, files)
+	if err != nil || len(orphans) != 0 {
+		t.Fatalf("CompareOrphans after writing = %v, %v, want no orphans", orphans, err)
+	}
+
+	esInfo, files = render(&GenSpec{Families: []string{FamilyMatch, FamilyTerm}})
+	orphans, err = CompareOrphans(outputPath, esInfo, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := len(Generators()) - 2
+	if len(orphans) != want {
+		t.Fatalf("CompareOrphans found %d orphans, want %d", len(orphans), want)
+	}
+	for _, d := range orphans {
+		if d.Status != FileDelete || !strings.Contains(d.Diff, "+++ /dev/null\n@@ -1,") {
+			t.Errorf("%s: status %s, diff does not delete the file:\n%.200s", d.Path, d.Status, d.Diff)
+		}
+	}
+}
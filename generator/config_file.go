@@ -0,0 +1,302 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kyle-hy/es2go/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// es2go.yaml配置文件，集中配置类型映射、异常字段、异常类型、忽略字段、字段注释和生成选项，
+// defaults为各索引的默认值，indices按索引覆盖。文件中的${VAR}和${VAR:-默认值}在解析前替换为环境变量
+
+// FileConfig es2go.yaml配置文件
+type FileConfig struct {
+	Defaults IndexConfig             `json:"defaults" yaml:"defaults"` // 各索引的默认配置
+	Indices  map[string]*IndexConfig `json:"indices" yaml:"indices"`   // 各索引的配置，键为索引名称
+}
+
+// IndexConfig 单个索引的配置，未设置的项使用defaults中的值，map类型的配置按键合并
+type IndexConfig struct {
+	In      string `json:"in" yaml:"in"`           // es mapping文件
+	Out     string `json:"out" yaml:"out"`         // 模型结构体文件
+	Package string `json:"package" yaml:"package"` // 代码包名，默认model
+	Struct  string `json:"struct" yaml:"struct"`   // 模型结构体名称，默认为索引名称的驼峰形式
+	Init    string `json:"init" yaml:"init"`       // 封装的类型名称
+
+	TypeMapping     map[string]string `json:"type_mapping" yaml:"type_mapping"`         // es类型与go类型的映射，在默认映射的基础上覆盖
+	FieldExceptions map[string]string `json:"field_exceptions" yaml:"field_exceptions"` // 异常字段
+	TypeExceptions  map[string]string `json:"type_exceptions" yaml:"type_exceptions"`   // 异常类型
+	SkipFields      map[string]bool   `json:"skip_fields" yaml:"skip_fields"`           // 忽略的字段
+	FieldComments   map[string]string `json:"field_comments" yaml:"field_comments"`     // 字段注释
+
+	Tmpl     string   `json:"tmpl" yaml:"tmpl"`           // 模型结构体的模板文件
+	TmplDir  string   `json:"tmpl_dir" yaml:"tmpl_dir"`   // 覆盖内置模板的目录
+	Spec     *GenSpec `json:"spec" yaml:"spec"`           // 生成规格，优先于spec_file
+	SpecFile string   `json:"spec_file" yaml:"spec_file"` // 生成规格文件
+	Stats    string   `json:"stats" yaml:"stats"`         // 字段去重值数量统计文件
+
+	OutDir          string `json:"out_dir" yaml:"out_dir"`                       // 输出目录
+	FilePattern     string `json:"file_pattern" yaml:"file_pattern"`             // 文件命名模式
+	SplitPackages   *bool  `json:"split_packages" yaml:"split_packages"`         // 模型和查询函数输出到不同的子目录
+	ModelImport     string `json:"model_import" yaml:"model_import"`             // model/子目录的导入路径
+	MaxFuncsPerFile *int   `json:"max_funcs_per_file" yaml:"max_funcs_per_file"` // 单个查询文件最多的函数数量
+	Doc             *bool  `json:"doc" yaml:"doc"`                               // 生成doc.go
+}
+
+// LoadConfigFile 加载配置文件，.json按JSON解析，其余按YAML解析，配置值中的环境变量在解析时替换，YAML的注释不做替换。
+// 配置中的相对路径以配置文件所在的目录为基准
+func LoadConfigFile(filePath string) (*FileConfig, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read config file %s: %w", filePath, err)
+	}
+
+	cfg := &FileConfig{}
+	missing := []string{}
+	if filepath.Ext(filePath) == ".json" {
+		// JSON没有注释，直接替换全文
+		data = []byte(expandEnv(string(data), &missing))
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("Error expanding config file %s: undefined environment variables: %s", filePath, strings.Join(missing, ", "))
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("Error unmarshalling config file %s: %w", jsonErrorPos(filePath, data, err), err)
+		}
+	} else {
+		err = decodeYAMLConfig(data, cfg, &missing)
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("Error expanding config file %s: undefined environment variables: %s", filePath, strings.Join(missing, ", "))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error unmarshalling config file %s: %w", filePath, err)
+		}
+	}
+
+	baseDir := filepath.Dir(filePath)
+	cfg.Defaults.resolvePaths(baseDir)
+	for _, idx := range cfg.Indices {
+		if idx != nil {
+			idx.resolvePaths(baseDir)
+		}
+	}
+	return cfg, nil
+}
+
+// IndexNames 配置的索引名称，按名称排序
+func (c *FileConfig) IndexNames() []string {
+	names := make([]string, 0, len(c.Indices))
+	for name := range c.Indices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Index 获取合并默认值后的索引配置
+func (c *FileConfig) Index(name string) (*IndexConfig, error) {
+	idx, ok := c.Indices[name]
+	if !ok {
+		return nil, fmt.Errorf("index %s not found in config, available: %s", name, strings.Join(c.IndexNames(), ", "))
+	}
+
+	merged := c.Defaults.merge(idx)
+	if merged.In == "" || merged.Out == "" {
+		return nil, fmt.Errorf("index %s must specify in and out", name)
+	}
+	if merged.Package == "" {
+		merged.Package = "model"
+	}
+	if merged.Struct == "" {
+		merged.Struct = utils.ToPascalCase(name)
+	}
+	return merged, nil
+}
+
+// merge 以当前配置为默认值，合并索引的配置
+func (c IndexConfig) merge(idx *IndexConfig) *IndexConfig {
+	m := c
+	if idx == nil {
+		return &m
+	}
+
+	overrideString(&m.In, idx.In)
+	overrideString(&m.Out, idx.Out)
+	overrideString(&m.Package, idx.Package)
+	overrideString(&m.Struct, idx.Struct)
+	overrideString(&m.Init, idx.Init)
+	overrideString(&m.Tmpl, idx.Tmpl)
+	overrideString(&m.TmplDir, idx.TmplDir)
+	overrideString(&m.SpecFile, idx.SpecFile)
+	overrideString(&m.Stats, idx.Stats)
+	overrideString(&m.OutDir, idx.OutDir)
+	overrideString(&m.FilePattern, idx.FilePattern)
+	overrideString(&m.ModelImport, idx.ModelImport)
+	if idx.Spec != nil {
+		m.Spec = idx.Spec
+	}
+	if idx.SplitPackages != nil {
+		m.SplitPackages = idx.SplitPackages
+	}
+	if idx.MaxFuncsPerFile != nil {
+		m.MaxFuncsPerFile = idx.MaxFuncsPerFile
+	}
+	if idx.Doc != nil {
+		m.Doc = idx.Doc
+	}
+
+	m.TypeMapping = mergeMap(c.TypeMapping, idx.TypeMapping)
+	m.FieldExceptions = mergeMap(c.FieldExceptions, idx.FieldExceptions)
+	m.TypeExceptions = mergeMap(c.TypeExceptions, idx.TypeExceptions)
+	m.SkipFields = mergeMap(c.SkipFields, idx.SkipFields)
+	m.FieldComments = mergeMap(c.FieldComments, idx.FieldComments)
+	return &m
+}
+
+// resolvePaths 将相对路径转换为以baseDir为基准的路径
+func (c *IndexConfig) resolvePaths(baseDir string) {
+	for _, p := range []*string{&c.In, &c.Out, &c.Tmpl, &c.TmplDir, &c.SpecFile, &c.Stats, &c.OutDir} {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(baseDir, *p)
+		}
+	}
+}
+
+// Config 索引的映射配置，类型映射在默认映射的基础上覆盖
+func (c *IndexConfig) Config() *Config {
+	return &Config{
+		GoTypeMap:       mergeMap(DefaultGoTypeMap(), c.TypeMapping),
+		FieldExceptions: mergeMap(nil, c.FieldExceptions),
+		TypeExceptions:  mergeMap(nil, c.TypeExceptions),
+		SkipFields:      mergeMap(nil, c.SkipFields),
+		FieldComments:   mergeMap(nil, c.FieldComments),
+	}
+}
+
+// Options 索引的生成选项
+func (c *IndexConfig) Options() *GenOptions {
+	opts := &GenOptions{
+		InitClassName: nonEmpty(c.Init),
+		TmplPath:      nonEmpty(c.Tmpl),
+		TmplDir:       nonEmpty(c.TmplDir),
+		StatsPath:     nonEmpty(c.Stats),
+		Spec:          c.Spec,
+		Config:        c.Config(),
+		Layout: &Layout{
+			OutDir:      c.OutDir,
+			FilePattern: c.FilePattern,
+			ModelImport: c.ModelImport,
+		},
+	}
+	if c.Spec == nil {
+		opts.SpecPath = nonEmpty(c.SpecFile)
+	}
+	if c.SplitPackages != nil {
+		opts.Layout.SplitPackages = *c.SplitPackages
+	}
+	if c.MaxFuncsPerFile != nil {
+		opts.Layout.MaxFuncsPerFile = *c.MaxFuncsPerFile
+	}
+	if c.Doc != nil {
+		opts.Layout.Doc = *c.Doc
+	}
+	return opts
+}
+
+// overrideString 非空时覆盖默认值
+func overrideString(dst *string, src string) {
+	if src != "" {
+		*dst = src
+	}
+}
+
+// mergeMap 复制base并使用override覆盖，结果不与参数共享
+func mergeMap[V any](base, override map[string]V) map[string]V {
+	m := make(map[string]V, len(base)+len(override))
+	for k, v := range base {
+		m[k] = v
+	}
+	for k, v := range override {
+		m[k] = v
+	}
+	return m
+}
+
+// nonEmpty 空字符串返回nil，用于构造GenOptions的可选项
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// decodeYAMLConfig 解析YAML配置，先替换各配置值中的环境变量，再检查未知的配置项后解析到v
+func decodeYAMLConfig(data []byte, v any, missing *[]string) error {
+	var root yaml.Node
+	err := yaml.Unmarshal(data, &root)
+	if err != nil {
+		return err
+	}
+	expandNode(&root, missing)
+	if len(*missing) > 0 {
+		return nil
+	}
+
+	expanded, err := yaml.Marshal(&root)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(expanded))
+	dec.KnownFields(true)
+	err = dec.Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil // 空文件
+	}
+	return err
+}
+
+// expandNode 替换YAML节点中各标量值的环境变量，替换后的无引号标量重新推断类型，以便数值和布尔值可以来自环境变量
+func expandNode(n *yaml.Node, missing *[]string) {
+	if n.Kind == yaml.ScalarNode {
+		value := expandEnv(n.Value, missing)
+		if value != n.Value {
+			n.Value = value
+			if n.Style == 0 {
+				n.Tag = ""
+			}
+		}
+	}
+	for _, c := range n.Content {
+		expandNode(c, missing)
+	}
+}
+
+// expandEnv 替换${VAR}、$VAR和${VAR:-默认值}形式的环境变量，$$表示$，未定义且没有默认值的变量记录到missing
+func expandEnv(s string, missing *[]string) string {
+	return os.Expand(s, func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+		key, def, hasDef := strings.Cut(name, ":-")
+		v, ok := os.LookupEnv(key)
+		if ok && (v != "" || !hasDef) {
+			return v
+		}
+		if hasDef {
+			return def
+		}
+		*missing = append(*missing, key)
+		return ""
+	})
+}
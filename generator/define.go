@@ -1,8 +1,12 @@
 package generator
 
+import "log/slog"
+
 // 全局常量
 const (
-	MaxCombine = 5
+	MaxCombine      = 5
+	MaxRangeCombine = 2 // 范围查询比较方式较多，限定到两个字段的组合
+	MaxGroupCombine = 3 // 分组统计最多组合的维度数量
 )
 
 // FuncTplData 预处理生产的函数模板需要的信息
@@ -11,6 +15,10 @@ type FuncTplData struct {
 	Comment string // 函数注释
 	Params  string // 参数列表
 	Query   string // 查询条件
+	Result  string // 返回结果类型，聚合类函数使用
+	Return  string // 返回语句，聚合类函数使用
+
+	Highlight string // 需要高亮的字段列表代码，检索类函数使用
 }
 
 // DetailTplData 生成详情的模板数据
@@ -21,6 +29,21 @@ type DetailTplData struct {
 	IndexName     string         // es索引名称(表名)
 	Fields        []*FieldInfo   // es相关字段信息
 	FuncDatas     []*FuncTplData // 预处理生产的函数模板需要的信息
+	ModelType     string         // 引用模型结构体的类型名称，模型在其他包时带包名限定，如model.Books
+	ModelImport   string         // 模型结构体所在包的导入路径，与查询函数同包时为空
+}
+
+// DocFileInfo doc.go中列出的文件信息
+type DocFileInfo struct {
+	Name  string // 文件名
+	Types int    // 类型数量
+	Funcs int    // 函数数量
+}
+
+// DocTplData 生成doc.go的模板数据
+type DocTplData struct {
+	PackageName string         // 代码包名
+	Files       []*DocFileInfo // 目录中es2go生成的文件，按文件名排序
 }
 
 /***************** es mapping 相关 **************************/
@@ -37,7 +60,10 @@ type Fields struct {
 
 // Meta 属性的注释说明
 type Meta struct {
-	Comment string `json:"comment,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	Cardinality string `json:"cardinality,omitempty"` // 基数提示，high表示高基数字段
+	Priority    string `json:"priority,omitempty"`    // 字段优先级，es要求meta的值为字符串，如"2"
+	Synonyms    string `json:"synonyms,omitempty"`    // 逗号分隔的同义词
 }
 
 // Property 字段属性
@@ -63,23 +89,44 @@ type ElasticsearchMapping struct {
 
 // FieldInfo es的mapping字段信息
 type FieldInfo struct {
-	FieldName     string // go的字段名称
-	FieldType     string // go的字段类型
-	JSONName      string // go的字段json字段标签,es字段原名
-	FieldComment  string // go的字段注释
-	FieldsKeyword string // go的字段text子字段keyword
-	EsFieldType   string // es的mapping字段类型
-	EsFieldPath   string // es的字段(嵌套)的访问路径
+	FieldName     string   // go的字段名称
+	FieldType     string   // go的字段类型
+	JSONName      string   // go的字段json字段标签,es字段原名
+	FieldComment  string   // go的字段注释
+	FieldsKeyword string   // go的字段text子字段keyword
+	EsFieldType   string   // es的mapping字段类型
+	EsFieldPath   string   // es的字段(嵌套)的访问路径
+	Cardinality   string   // es的字段基数提示，取自meta.cardinality
+	Priority      string   // es的字段优先级，取自meta.priority
+	Synonyms      []string // es的字段同义词，取自meta.synonyms
+	Score         float64  // 字段重要性得分，用于函数数量受限时挑选字段组合
 }
 
 // EsModelInfo ES库表模型的信息
 type EsModelInfo struct {
-	PackageName   string       // go的包名
-	InitClassName string       // go自定义封装的类型名称
-	StructName    string       // go的模型结构体名称
-	StructComment string       // go的模型结构体注释
-	IndexName     string       // es的索引(表)名称
-	Fields        []*FieldInfo // es相关字段信息
+	PackageName       string       // go的包名
+	InitClassName     string       // go自定义封装的类型名称
+	StructName        string       // go的模型结构体名称
+	StructComment     string       // go的模型结构体注释
+	StructDefinitions string       // go的模型结构体及嵌套结构体的定义，渲染模型结构体时使用
+	IndexName         string       // es的索引(表)名称
+	Fields            []*FieldInfo // es相关字段信息
+	Spec              *GenSpec     // 生成规格，为空时按默认规则生成
+	TmplDir           string       // 覆盖内置模板的目录，为空时使用内置模板
+	Layout            *Layout      // 输出文件的布局
+	ModelPath         string       // go的模型结构体文件路径
+	ModelSource       []byte       // go的模型结构体代码，已格式化
+	Logger            *slog.Logger `json:"-"` // 生成过程的日志，为空时不输出
+
+	formatOnly bool // 只格式化代码，不由goimports查找缺少的导入，库方式渲染时设置
+}
+
+// logger 生成过程的日志，未指定时丢弃
+func (e *EsModelInfo) logger() *slog.Logger {
+	if e.Logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+	return e.Logger
 }
 
 // GroupFieldsByType 按照数据类型划分字段
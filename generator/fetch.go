@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// 从es集群获取索引的mapping，保存为es2go的mapping文件格式({"mappings": {...}})
+
+// FetchOptions 获取mapping的连接参数
+type FetchOptions struct {
+	URL      string       // es地址，如http://localhost:9200
+	Username string       // 用户名，为空时不认证
+	Password string       // 密码
+	APIKey   string       // API Key，优先于用户名密码
+	Client   *http.Client // 为空时使用http.DefaultClient
+}
+
+// FetchMapping 获取索引的mapping，index为别名时取其指向的唯一索引，返回格式化的mapping文件内容
+func FetchMapping(ctx context.Context, index string, opts FetchOptions) ([]byte, error) {
+	endpoint, err := url.JoinPath(strings.TrimSuffix(opts.URL, "/"), index, "_mapping")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to build mapping url of %s: %v", index, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case opts.APIKey != "":
+		req.Header.Set("Authorization", "ApiKey "+opts.APIKey)
+	case opts.Username != "":
+		req.SetBasicAuth(opts.Username, opts.Password)
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to fetch mapping of %s: %v", index, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read mapping of %s: %v", index, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to fetch mapping of %s: %s: %s", index, resp.Status, bytes.TrimSpace(body))
+	}
+
+	// 响应按实际的索引名称分组：{"books": {"mappings": {...}}}
+	var indices map[string]json.RawMessage
+	err = json.Unmarshal(body, &indices)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse mapping of %s: %v", index, err)
+	}
+	if len(indices) != 1 {
+		names := make([]string, 0, len(indices))
+		for name := range indices {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%s matches %d indices, specify a single index: %s", index, len(indices), strings.Join(names, ", "))
+	}
+
+	var buf bytes.Buffer
+	for _, mapping := range indices {
+		err = json.Indent(&buf, mapping, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("Failed to format mapping of %s: %v", index, err)
+		}
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,208 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// 在进程内格式化生成的代码，不依赖外部的goimports命令
+
+// formatSource 格式化生成的代码并清理未使用的导入，语法错误时附带出错的代码行和生成器名称。
+// 导入完整时只在进程内处理，自定义模板缺少导入时才由goimports查找，此时需要调用go命令；
+// formatOnly时始终只格式化，不查找缺少的导入
+func formatSource(generator, filename string, src []byte, formatOnly bool) ([]byte, error) {
+	opts := &imports.Options{Comments: true, TabIndent: true, TabWidth: 8, FormatOnly: formatOnly}
+	if pruned, ok := removeUnusedImports(filename, src); ok {
+		src, opts.FormatOnly = pruned, true
+	}
+	out, err := imports.Process(filename, src, opts)
+	if err == nil {
+		return out, nil
+	}
+
+	var errList scanner.ErrorList
+	if !errors.As(err, &errList) || len(errList) == 0 {
+		return nil, fmt.Errorf("Failed to format code generated by %s for %s: %v", generator, filename, err)
+	}
+
+	// 定位出错的代码行
+	first := errList[0]
+	line := ""
+	lines := bytes.Split(src, []byte("\n"))
+	if first.Pos.Line > 0 && first.Pos.Line <= len(lines) {
+		line = string(bytes.TrimSpace(lines[first.Pos.Line-1]))
+	}
+	return nil, fmt.Errorf("Generator %s produced invalid code for %s at line %d: %s\n\t%s",
+		generator, filename, first.Pos.Line, first.Msg, line)
+}
+
+// removeUnusedImports 删除未使用的导入，包名按goimports的规则由导入路径推断。
+// 代码有语法错误或引用了未导入的包时返回false，交由goimports处理
+func removeUnusedImports(filename string, src []byte) ([]byte, bool) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, false
+	}
+
+	// 未解析的标识符作为选择器的前缀时视为对包的引用
+	refs := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil {
+				refs[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	imported := map[string]bool{}
+	unused := []*ast.ImportSpec{}
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return nil, false
+		}
+		name := importName(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		if name == "_" || name == "." {
+			continue
+		}
+		imported[name] = true
+		if !refs[name] {
+			unused = append(unused, imp)
+		}
+	}
+	for ref := range refs {
+		if !imported[ref] {
+			return nil, false
+		}
+	}
+	if len(unused) == 0 {
+		return src, true
+	}
+
+	for _, imp := range unused {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		name := ""
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		astutil.DeleteNamedImport(fset, file, name, importPath)
+	}
+	var buf bytes.Buffer
+	err = format.Node(&buf, fset, file)
+	if err != nil {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// importName 由导入路径推断包名：取最后一段，版本后缀(如/v8)取上一段，去掉go-前缀和非标识符字符之后的部分
+func importName(importPath string) string {
+	base := path.Base(importPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil && path.Dir(importPath) != "." {
+			base = path.Base(path.Dir(importPath))
+		}
+	}
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		base = base[:i]
+	}
+	return base
+}
+
+// OutputFile 渲染并格式化后待写入的代码文件
+type OutputFile struct {
+	Path      string // 输出文件路径
+	Generator string // 生成该文件的生成器名称
+	Source    []byte // 格式化后的代码
+}
+
+// newOutputFile 格式化生成的代码，格式化失败时返回错误
+func newOutputFile(generator, outputPath string, src []byte, formatOnly bool) (*OutputFile, error) {
+	out, err := formatSource(generator, outputPath, src, formatOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &OutputFile{Path: outputPath, Generator: generator, Source: out}, nil
+}
+
+// Write 将代码写入文件，目录不存在时先创建目录
+func (f *OutputFile) Write() error {
+	err := os.MkdirAll(filepath.Dir(f.Path), 0755)
+	if err != nil {
+		return fmt.Errorf("Failed to create output directory %s: %v", filepath.Dir(f.Path), err)
+	}
+	err = os.WriteFile(f.Path, f.Source, 0644)
+	if err != nil {
+		return fmt.Errorf("Failed to write output file %s: %v", f.Path, err)
+	}
+	return nil
+}
+
+// RenderAll 渲染模型结构体、通用辅助函数和全部已注册生成器的代码，布局启用doc.go时一并渲染，不写入文件。
+// 渲染失败的文件不在结果中，错误合并后返回
+func RenderAll(outputPath string, esInfo *EsModelInfo) ([]*OutputFile, error) {
+	files := []*OutputFile{}
+	errs := []error{}
+	if esInfo.ModelSource != nil {
+		files = append(files, &OutputFile{Path: esInfo.ModelPath, Generator: "model", Source: esInfo.ModelSource})
+	}
+
+	common, err := RenderCommon(outputPath, esInfo)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		files = append(files, common)
+	}
+
+	for _, g := range Generators() {
+		rendered, err := Render(g, outputPath, esInfo)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		files = append(files, rendered...)
+	}
+
+	if esInfo.Layout != nil && esInfo.Layout.Doc {
+		docs, err := RenderDocs(outputPath, esInfo, files)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		files = append(files, docs...)
+	}
+	return files, errors.Join(errs...)
+}
+
+// WriteFiles 依次写入代码文件
+func WriteFiles(files []*OutputFile) error {
+	for _, f := range files {
+		err := f.Write()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 聚合类查询函数共用的预处理逻辑
+
+// HighCardinality 字段meta中标记高基数的取值
+const HighCardinality = "high"
+
+// 聚合统计的指标
+var (
+	Count       = "Count"
+	Sum         = "Sum"
+	Avg         = "Avg"
+	Min         = "Min"
+	Max         = "Max"
+	metricList  = []string{Sum, Avg, Min, Max}
+	metricNames = map[string]string{
+		Count: "数量",
+		Sum:   "总和",
+		Avg:   "平均值",
+		Min:   "最小值",
+		Max:   "最大值",
+	}
+)
+
+// aggMetric 统计的指标字段及统计方式，Count统计时没有字段
+type aggMetric struct {
+	Field  *FieldInfo
+	Metric string
+}
+
+// aggMetrics 提取统计指标，包括文档数量和各数值字段的统计方式
+func aggMetrics(spec *GenSpec, grpFileds map[string][]*FieldInfo) []*aggMetric {
+	metrics := []*aggMetric{{Metric: Count}}
+	for _, f := range spec.RoleFields(RoleMetric, grpFileds[TypeNumber]) {
+		for _, m := range metricList {
+			metrics = append(metrics, &aggMetric{Field: f, Metric: m})
+		}
+	}
+	return metrics
+}
+
+// fields 合并统计指标的字段与其他字段，用于检查字段组合
+func (m *aggMetric) fields(others ...*FieldInfo) []*FieldInfo {
+	fields := append([]*FieldInfo{}, others...)
+	if m.Field != nil {
+		fields = append(fields, m.Field)
+	}
+	return fields
+}
+
+// getAggMetricFuncName 获取统计指标部分的函数名称
+func getAggMetricFuncName(metric *aggMetric) string {
+	if metric.Field == nil {
+		return metric.Metric
+	}
+	return metric.Metric + metric.Field.FieldName
+}
+
+// getAggMetricFuncComment 获取统计指标部分的函数注释
+func getAggMetricFuncComment(metric *aggMetric) string {
+	if metric.Field == nil {
+		return "的" + metricNames[metric.Metric]
+	}
+	return "中" + metric.Field.FieldComment + "的" + metricNames[metric.Metric]
+}
+
+// getAggMetricQuery 获取统计指标的聚合条件，生成metric变量，统计数量时为空
+func getAggMetricQuery(metric *aggMetric) string {
+	if metric.Field == nil {
+		return "var metric eq.Map"
+	}
+	return fmt.Sprintf("metric := eq.Map{\"%s\": eq.Map{\"field\": \"%s\"}}", strings.ToLower(metric.Metric), metric.Field.EsFieldPath)
+}
+
+// aggFilterFields 提取聚合查询可用作过滤条件的字段，与精确查询的条件字段一致
+func aggFilterFields(spec *GenSpec, grpFileds map[string][]*FieldInfo) []*FieldInfo {
+	fields := []*FieldInfo{}
+	fields = append(fields, grpFileds[TypeKeyword]...) // keyword字段
+	fields = append(fields, grpFileds[TypeNumber]...)  // 数值
+	return spec.RoleFields(RoleFilter, fields)
+}
+
+// eachAggFilterCombination 依次遍历目标字段之外的过滤字段组合，首个为无过滤条件的空组合，fn返回false时停止遍历
+func eachAggFilterCombination(spec *GenSpec, target *FieldInfo, fields []*FieldInfo, maxCount int, fn func(filters []*FieldInfo) bool) bool {
+	visit := func(cfs []*FieldInfo) bool {
+		if !spec.Allow([]*FieldInfo{target}, cfs) {
+			return true
+		}
+		return fn(cfs)
+	}
+	if !visit([]*FieldInfo{}) {
+		return false
+	}
+
+	others := utils.FilterOut(fields, []*FieldInfo{target})
+	return utils.EachCombination(others, maxCount, visit)
+}
+
+// aggFieldPath 获取聚合使用的字段路径，text字段使用keyword子字段
+func aggFieldPath(f *FieldInfo) string {
+	if f.EsFieldType == "text" && f.FieldsKeyword == "keyword" {
+		return f.EsFieldPath + ".keyword"
+	}
+	return f.EsFieldPath
+}
+
+// getAggFilterFuncName 获取过滤条件部分的函数名称
+func getAggFilterFuncName(filters []*FieldInfo) string {
+	if len(filters) == 0 {
+		return ""
+	}
+
+	fn := "By"
+	for _, f := range filters {
+		fn += f.FieldName
+	}
+	return fn
+}
+
+// getAggFilterFuncComment 获取过滤条件部分的函数注释
+func getAggFilterFuncComment(filters []*FieldInfo) string {
+	if len(filters) == 0 {
+		return ""
+	}
+
+	cmt := "以"
+	for _, f := range filters {
+		cmt += f.FieldComment + "、"
+	}
+	cmt = strings.TrimSuffix(cmt, "、")
+	cmt += "为过滤条件"
+	return cmt
+}
+
+// getAggFilterParamComment 获取过滤条件的参数注释
+func getAggFilterParamComment(filters []*FieldInfo) string {
+	cmt := ""
+	for _, f := range filters {
+		cmt += "\n// " + utils.ToFirstLower(f.FieldName) + " " + f.FieldType + " " + f.FieldComment
+	}
+	return cmt
+}
+
+// getAggFilterFuncParams 获取过滤条件的参数列表
+func getAggFilterFuncParams(filters []*FieldInfo) string {
+	fp := ""
+	for _, f := range filters {
+		fp += utils.ToFirstLower(f.FieldName) + " " + f.FieldType + ", "
+	}
+	fp = strings.TrimSuffix(fp, ", ")
+	return fp
+}
+
+// joinParams 拼接参数列表，忽略空的部分
+func joinParams(params ...string) string {
+	fps := []string{}
+	for _, p := range params {
+		if p != "" {
+			fps = append(fps, p)
+		}
+	}
+	return strings.Join(fps, ", ")
+}
+
+// getAggFilterQuery 获取聚合函数的过滤查询条件，生成query变量
+func getAggFilterQuery(filters []*FieldInfo) string {
+	if len(filters) == 0 {
+		return `query := eq.Map{"match_all": eq.Map{}}`
+	}
+
+	fq := "filters := []eq.Map{\n"
+	for _, f := range filters {
+		fq += fmt.Sprintf("		eq.Term(\"%s\", %s),\n", f.EsFieldPath, utils.ToFirstLower(f.FieldName))
+	}
+	fq += "	}\n"
+	fq += `	query := eq.Bool(eq.WithFilter(filters))`
+	return fq
+}
+
+// AggTpl 聚合查询代码模板
+var AggTpl = builtinTemplate("agg.tmpl")
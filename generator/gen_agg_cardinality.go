@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// 生成查询keyword字段去重数量的代码
+
+// PreAggCardinalityCond 使用go代码预处理渲染需要的一些逻辑，template脚本调试困难
+func PreAggCardinalityCond(esInfo *EsModelInfo) []*FuncTplData {
+	// 按数据类型分组字段
+	grpFileds := GroupFieldsByType(esInfo.Fields)
+
+	// 提取目标字段
+	fields := grpFileds[TypeKeyword]                       // keyword字段
+	fields = append(fields, grpFileds[TypeTextKeyword]...) // 带keyword子字段的text字段
+	fields = esInfo.Spec.RoleFields(RoleGroup, fields)
+	filterFields := aggFilterFields(esInfo.Spec, grpFileds)
+
+	// 目标字段与过滤条件组合
+	collector := newFuncCollector(esInfo, FamilyCardinality)
+	maxCombine := esInfo.Spec.CombineLimit(FamilyCardinality, MaxCombine)
+	for _, f := range fields {
+		eachAggFilterCombination(esInfo.Spec, f, filterFields, maxCombine-1, func(cfs []*FieldInfo) bool {
+			collector.Add(append([]*FieldInfo{f}, cfs...), func() *FuncTplData {
+				return &FuncTplData{
+					Name:    getAggCardinalityFuncName(esInfo.StructName, f, cfs),
+					Comment: getAggCardinalityFuncComment(esInfo.StructComment, f, cfs),
+					Params:  getAggFilterFuncParams(cfs),
+					Query:   getAggFilterQuery(cfs),
+					Result:  "int64",
+					Return:  getAggCardinalityReturn(esInfo.StructName, f),
+				}
+			})
+			return true
+		})
+	}
+
+	return collector.Result()
+}
+
+// getAggCardinalityFuncName 获取函数名称
+func getAggCardinalityFuncName(structName string, field *FieldInfo, filters []*FieldInfo) string {
+	return "Cardinality" + structName + field.FieldName + getAggFilterFuncName(filters)
+}
+
+// getAggCardinalityFuncComment 获取函数注释
+func getAggCardinalityFuncComment(structComment string, field *FieldInfo, filters []*FieldInfo) string {
+	cmt := getAggFilterFuncComment(filters)
+	cmt += "查询" + structComment + "中" + field.FieldComment + "的去重数量"
+	cmt += getAggFilterParamComment(filters)
+	return cmt
+}
+
+// getAggCardinalityReturn 获取函数的返回语句
+func getAggCardinalityReturn(structName string, field *FieldInfo) string {
+	return fmt.Sprintf("return cardinality%s(es, \"%s\", query)", structName, aggFieldPath(field))
+}
+
+// AggCardinalityGenerator 字段去重数量查询的生成器
+var AggCardinalityGenerator = newBuiltinGenerator(FamilyCardinality, "_agg_cardinality", PreAggCardinalityCond, "agg.tmpl")
+
+// GenEsAggCardinality 生成es去重数量查询
+func GenEsAggCardinality(outputPath string, esInfo *EsModelInfo) error {
+	return Generate(AggCardinalityGenerator, outputPath, esInfo)
+}
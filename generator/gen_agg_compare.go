@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// 生成按日期字段同比、环比统计指标的代码
+
+// PreAggCompareCond 使用go代码预处理渲染需要的一些逻辑，template脚本调试困难
+func PreAggCompareCond(esInfo *EsModelInfo) []*FuncTplData {
+	// 按数据类型分组字段
+	grpFileds := GroupFieldsByType(esInfo.Fields)
+
+	// 提取日期字段和统计指标
+	dateFields := esInfo.Spec.RoleFields(RoleGroup, grpFileds[TypeDate])
+	metrics := aggMetrics(esInfo.Spec, grpFileds)
+
+	// 日期字段与统计指标组合
+	collector := newFuncCollector(esInfo, FamilyCompare)
+	for _, f := range dateFields {
+		for _, m := range metrics {
+			if !esInfo.Spec.Allow(m.fields(f)) {
+				continue
+			}
+			collector.Add(m.fields(f), func() *FuncTplData {
+				return &FuncTplData{
+					Name:    getAggCompareFuncName(esInfo.StructName, m, f),
+					Comment: getAggCompareFuncComment(esInfo.StructComment, m, f),
+					Params:  "refTime time.Time, period string, yearOnYear bool",
+					Query:   getAggFilterQuery(nil) + "\n	" + getAggMetricQuery(m),
+					Result:  "*" + esInfo.StructName + "PeriodCompare",
+					Return:  fmt.Sprintf("return compare%s(es, \"%s\", refTime, period, yearOnYear, metric, query)", esInfo.StructName, f.EsFieldPath),
+				}
+			})
+		}
+	}
+
+	return collector.Result()
+}
+
+// getAggCompareFuncName 获取函数名称
+func getAggCompareFuncName(structName string, metric *aggMetric, field *FieldInfo) string {
+	return "Compare" + structName + getAggMetricFuncName(metric) + "By" + field.FieldName
+}
+
+// getAggCompareFuncComment 获取函数注释
+func getAggCompareFuncComment(structComment string, metric *aggMetric, field *FieldInfo) string {
+	cmt := "按" + field.FieldComment + "对比" + structComment + "本期与上期" + getAggMetricFuncComment(metric)
+	cmt += "，返回本期值、上期值、变化量和变化百分比"
+	cmt += "\n// refTime time.Time 参考时间，本期为其所在的统计周期"
+	cmt += "\n// period string 统计周期，可选day、week、month、quarter、year"
+	cmt += "\n// yearOnYear bool 为true时同比，上期为去年同一周期；否则环比，上期为相邻的前一周期"
+	return cmt
+}
+
+// AggCompareGenerator 同比环比统计的生成器
+var AggCompareGenerator = newBuiltinGenerator(FamilyCompare, "_agg_compare", PreAggCompareCond, "agg.tmpl")
+
+// GenEsAggCompare 生成es同比环比统计
+func GenEsAggCompare(outputPath string, esInfo *EsModelInfo) error {
+	return Generate(AggCompareGenerator, outputPath, esInfo)
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// 生成查询keyword字段所有去重值的代码
+
+// PreAggDistinctCond 使用go代码预处理渲染需要的一些逻辑，template脚本调试困难
+func PreAggDistinctCond(esInfo *EsModelInfo) []*FuncTplData {
+	// 按数据类型分组字段
+	grpFileds := GroupFieldsByType(esInfo.Fields)
+
+	// 提取目标字段
+	fields := grpFileds[TypeKeyword]                       // keyword字段
+	fields = append(fields, grpFileds[TypeTextKeyword]...) // 带keyword子字段的text字段
+	fields = esInfo.Spec.RoleFields(RoleGroup, fields)
+	filterFields := aggFilterFields(esInfo.Spec, grpFileds)
+
+	// 目标字段与过滤条件组合
+	collector := newFuncCollector(esInfo, FamilyDistinct)
+	maxCombine := esInfo.Spec.CombineLimit(FamilyDistinct, MaxCombine)
+	for _, f := range fields {
+		eachAggFilterCombination(esInfo.Spec, f, filterFields, maxCombine-1, func(cfs []*FieldInfo) bool {
+			collector.Add(append([]*FieldInfo{f}, cfs...), func() *FuncTplData {
+				return &FuncTplData{
+					Name:    getAggDistinctFuncName(esInfo.StructName, f, cfs),
+					Comment: getAggDistinctFuncComment(esInfo.StructComment, f, cfs),
+					Params:  getAggFilterFuncParams(cfs),
+					Query:   getAggFilterQuery(cfs),
+					Result:  "[]" + f.FieldType,
+					Return:  getAggDistinctReturn(esInfo.StructName, f),
+				}
+			})
+			return true
+		})
+	}
+
+	return collector.Result()
+}
+
+// getAggDistinctFuncName 获取函数名称
+func getAggDistinctFuncName(structName string, field *FieldInfo, filters []*FieldInfo) string {
+	return "Distinct" + structName + field.FieldName + getAggFilterFuncName(filters)
+}
+
+// getAggDistinctFuncComment 获取函数注释
+func getAggDistinctFuncComment(structComment string, field *FieldInfo, filters []*FieldInfo) string {
+	cmt := getAggFilterFuncComment(filters)
+	cmt += "查询" + structComment + "中" + field.FieldComment + "的所有去重值"
+	cmt += getAggFilterParamComment(filters)
+	return cmt
+}
+
+// getAggDistinctReturn 获取函数的返回语句，高基数字段使用composite聚合分页获取
+func getAggDistinctReturn(structName string, field *FieldInfo) string {
+	helper := "distinct" + structName + "Terms"
+	if field.Cardinality == HighCardinality {
+		helper = "distinct" + structName + "Composite"
+	}
+	return fmt.Sprintf("return %s[%s](es, \"%s\", query)", helper, field.FieldType, aggFieldPath(field))
+}
+
+// AggDistinctGenerator 字段去重值查询的生成器
+var AggDistinctGenerator = newBuiltinGenerator(FamilyDistinct, "_agg_distinct", PreAggDistinctCond, "agg.tmpl")
+
+// GenEsAggDistinct 生成es去重值查询
+func GenEsAggDistinct(outputPath string, esInfo *EsModelInfo) error {
+	return Generate(AggDistinctGenerator, outputPath, esInfo)
+}
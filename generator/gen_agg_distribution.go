@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 生成数值字段百分位、百分位排名和直方图分布的代码
+
+// 分布统计方式
+var (
+	Percentiles     = "Percentiles"
+	PercentileRanks = "PercentileRanks"
+	Histogram       = "Histogram"
+	distList        = []string{Percentiles, PercentileRanks, Histogram}
+	distNames       = map[string]string{
+		Percentiles:     "的百分位数值",
+		PercentileRanks: "指定数值的百分位排名",
+		Histogram:       "按固定间隔统计的直方图分布",
+	}
+	distParams = map[string]string{
+		Percentiles:     "percents []float64",
+		PercentileRanks: "values []float64",
+		Histogram:       "interval float64",
+	}
+	distParamCmts = map[string]string{
+		Percentiles:     "percents []float64 需要计算的百分位，如50、90、99",
+		PercentileRanks: "values []float64 需要计算百分位排名的数值",
+		Histogram:       "interval float64 直方图分组的固定间隔",
+	}
+)
+
+// PreAggDistributionCond 使用go代码预处理渲染需要的一些逻辑，template脚本调试困难
+func PreAggDistributionCond(esInfo *EsModelInfo) []*FuncTplData {
+	// 按数据类型分组字段
+	grpFileds := GroupFieldsByType(esInfo.Fields)
+
+	// 提取目标字段
+	fields := esInfo.Spec.RoleFields(RoleMetric, grpFileds[TypeNumber])
+	filterFields := aggFilterFields(esInfo.Spec, grpFileds)
+
+	// 目标字段、统计方式与过滤条件组合
+	collector := newFuncCollector(esInfo, FamilyDistribution)
+	maxCombine := esInfo.Spec.CombineLimit(FamilyDistribution, MaxCombine)
+	for _, f := range fields {
+		for _, dist := range distList {
+			eachAggFilterCombination(esInfo.Spec, f, filterFields, maxCombine-1, func(cfs []*FieldInfo) bool {
+				collector.Add(append([]*FieldInfo{f}, cfs...), func() *FuncTplData {
+					return &FuncTplData{
+						Name:    getAggDistributionFuncName(esInfo.StructName, dist, f, cfs),
+						Comment: getAggDistributionFuncComment(esInfo.StructComment, dist, f, cfs),
+						Params:  joinParams(getAggFilterFuncParams(cfs), distParams[dist]),
+						Query:   getAggFilterQuery(cfs),
+						Result:  getAggDistributionResult(esInfo.StructName, dist),
+						Return:  getAggDistributionReturn(esInfo.StructName, dist, f),
+					}
+				})
+				return true
+			})
+		}
+	}
+
+	return collector.Result()
+}
+
+// getAggDistributionFuncName 获取函数名称
+func getAggDistributionFuncName(structName, dist string, field *FieldInfo, filters []*FieldInfo) string {
+	return dist + structName + field.FieldName + getAggFilterFuncName(filters)
+}
+
+// getAggDistributionFuncComment 获取函数注释
+func getAggDistributionFuncComment(structComment, dist string, field *FieldInfo, filters []*FieldInfo) string {
+	cmt := getAggFilterFuncComment(filters)
+	cmt += "统计" + structComment + "中" + field.FieldComment + distNames[dist]
+	cmt += getAggFilterParamComment(filters)
+	cmt += "\n// " + distParamCmts[dist]
+	return cmt
+}
+
+// getAggDistributionResult 获取函数的返回结果类型
+func getAggDistributionResult(structName, dist string) string {
+	if dist == Histogram {
+		return "[]" + structName + "HistogramBucket"
+	}
+	return "[]" + structName + "Percentile"
+}
+
+// getAggDistributionReturn 获取函数的返回语句
+func getAggDistributionReturn(structName, dist string, field *FieldInfo) string {
+	arg := strings.Fields(distParams[dist])[0]
+	helper := strings.ToLower(dist[:1]) + dist[1:] + structName
+	return fmt.Sprintf("return %s(es, \"%s\", %s, query)", helper, field.EsFieldPath, arg)
+}
+
+// AggDistributionGenerator 数值分布统计的生成器
+var AggDistributionGenerator = newBuiltinGenerator(FamilyDistribution, "_agg_distribution", PreAggDistributionCond, "agg.tmpl")
+
+// GenEsAggDistribution 生成es数值分布统计
+func GenEsAggDistribution(outputPath string, esInfo *EsModelInfo) error {
+	return Generate(AggDistributionGenerator, outputPath, esInfo)
+}
@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 生成按多个维度分组统计指标的代码
+
+// groupCombineLimit 分组统计最多组合的维度数量，规格只能减少，不超过MaxGroupCombine，
+// 组合数量随维度数量急剧增长，且composite聚合的维度过多时难以使用
+func groupCombineLimit(spec *GenSpec) int {
+	return min(spec.CombineLimit(FamilyGroup, MaxGroupCombine), MaxGroupCombine)
+}
+
+// PreAggGroupCond 使用go代码预处理渲染需要的一些逻辑，template脚本调试困难
+func PreAggGroupCond(esInfo *EsModelInfo) []*FuncTplData {
+	// 按数据类型分组字段
+	grpFileds := GroupFieldsByType(esInfo.Fields)
+
+	// 提取分组维度字段
+	dimFields := grpFileds[TypeKeyword]                          // keyword字段
+	dimFields = append(dimFields, grpFileds[TypeTextKeyword]...) // 带keyword子字段的text字段
+	dimFields = append(dimFields, grpFileds[TypeDate]...)        // 日期字段
+	dimFields = esInfo.Spec.RoleFields(RoleGroup, dimFields)
+
+	// 提取统计指标
+	metrics := aggMetrics(esInfo.Spec, grpFileds)
+
+	// 分组维度与统计指标组合
+	collector := newFuncCollector(esInfo, FamilyGroup)
+	utils.EachCombination(dimFields, groupCombineLimit(esInfo.Spec), func(cfs []*FieldInfo) bool {
+		for _, m := range metrics {
+			if !esInfo.Spec.Allow(m.fields(cfs...)) {
+				continue
+			}
+			collector.Add(m.fields(cfs...), func() *FuncTplData {
+				return &FuncTplData{
+					Name:    getAggGroupFuncName(esInfo.StructName, m, cfs),
+					Comment: getAggGroupFuncComment(esInfo.StructComment, m, cfs),
+					Params:  getAggGroupFuncParams(cfs),
+					Query:   getAggGroupQuery(m, cfs),
+					Result:  "[]" + esInfo.StructName + "GroupRow",
+					Return:  fmt.Sprintf("return group%s(es, sources, metric, query)", esInfo.StructName),
+				}
+			})
+		}
+		return true
+	})
+
+	return collector.Result()
+}
+
+// getAggGroupFuncName 获取函数名称
+func getAggGroupFuncName(structName string, metric *aggMetric, fields []*FieldInfo) string {
+	fn := "Group" + structName + getAggMetricFuncName(metric) + "By"
+	for _, f := range fields {
+		fn += f.FieldName
+	}
+	return fn
+}
+
+// getAggGroupFuncComment 获取函数注释
+func getAggGroupFuncComment(structComment string, metric *aggMetric, fields []*FieldInfo) string {
+	// 函数注释
+	cmt := "按"
+	for _, f := range fields {
+		cmt += f.FieldComment + "、"
+	}
+	cmt = strings.TrimSuffix(cmt, "、")
+	cmt += "分组统计" + structComment + getAggMetricFuncComment(metric)
+
+	// 参数注释
+	for _, f := range fields {
+		if getTypeMapping(f.EsFieldType) == TypeDate {
+			cmt += "\n// " + utils.ToFirstLower(f.FieldName) + "Interval string " + f.FieldComment + "的分组间隔，如day、week、month、quarter、year"
+		}
+	}
+
+	return cmt
+}
+
+// getAggGroupFuncParams 获取函数参数列表，日期维度需要指定分组间隔
+func getAggGroupFuncParams(fields []*FieldInfo) string {
+	fp := ""
+	for _, f := range fields {
+		if getTypeMapping(f.EsFieldType) == TypeDate {
+			fp += utils.ToFirstLower(f.FieldName) + "Interval string, "
+		}
+	}
+	fp = strings.TrimSuffix(fp, ", ")
+	return fp
+}
+
+// getAggGroupQuery 获取函数的查询条件、分组维度和统计指标
+func getAggGroupQuery(metric *aggMetric, fields []*FieldInfo) string {
+	fq := getAggFilterQuery(nil) + "\n"
+
+	// 分组维度
+	fq += "	sources := []eq.Map{\n"
+	for _, f := range fields {
+		if getTypeMapping(f.EsFieldType) == TypeDate {
+			fq += fmt.Sprintf("		{\"%s\": eq.Map{\"date_histogram\": eq.Map{\"field\": \"%s\", \"calendar_interval\": %sInterval, \"format\": \"yyyy-MM-dd\"}}},\n",
+				f.EsFieldPath, f.EsFieldPath, utils.ToFirstLower(f.FieldName))
+		} else {
+			fq += fmt.Sprintf("		{\"%s\": eq.Map{\"terms\": eq.Map{\"field\": \"%s\"}}},\n", f.EsFieldPath, aggFieldPath(f))
+		}
+	}
+	fq += "	}\n"
+
+	// 统计指标
+	fq += "	" + getAggMetricQuery(metric)
+	return fq
+}
+
+// AggGroupGenerator 多维度分组统计的生成器
+var AggGroupGenerator = newBuiltinGenerator(FamilyGroup, "_agg_group", PreAggGroupCond, "agg.tmpl")
+
+// GenEsAggGroup 生成es多维度分组统计
+func GenEsAggGroup(outputPath string, esInfo *EsModelInfo) error {
+	return Generate(AggGroupGenerator, outputPath, esInfo)
+}
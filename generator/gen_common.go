@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// 生成各查询函数共用的辅助代码，每个模型单独一个文件，各类查询函数的生成可以独立启用
+
+// GenEsCommon 生成es查询的通用辅助函数
+func GenEsCommon(outputPath string, esInfo *EsModelInfo) error {
+	file, err := RenderCommon(outputPath, esInfo)
+	if err != nil {
+		return err
+	}
+	return file.Write()
+}
+
+// RenderCommon 渲染es查询的通用辅助函数，不写入文件
+func RenderCommon(outputPath string, esInfo *EsModelInfo) (*OutputFile, error) {
+	start := time.Now()
+	detailData := newDetailTplData(esInfo, nil)
+
+	// 渲染
+	tmpl, err := loadTemplate("structCommon", esInfo.TmplDir, []string{"common.tmpl"}, CommonTpl)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, detailData)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing common template: %v", err)
+	}
+
+	// 格式化代码
+	path := esInfo.Layout.QueryPath(outputPath, "common", "_common", 0)
+	file, err := newOutputFile("common", path, buf.Bytes(), esInfo.formatOnly)
+	if err != nil {
+		return nil, err
+	}
+	esInfo.logger().Debug("Rendered common helpers", "elapsed", time.Since(start))
+	return file, nil
+}
+
+// CommonTpl 通用辅助函数代码模板
+var CommonTpl = builtinTemplate("common.tmpl")
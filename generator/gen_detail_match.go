@@ -1,12 +1,8 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
-	"text/template"
 
 	"github.com/kyle-hy/es2go/utils"
 )
@@ -15,27 +11,32 @@ import (
 
 // PreDetailMatchCond 使用go代码预处理渲染需要的一些逻辑，template脚本出来调试困难
 func PreDetailMatchCond(esInfo *EsModelInfo) []*FuncTplData {
-	funcDatas := []*FuncTplData{}
-
 	// 按数据类型分组字段
 	grpFileds := GroupFieldsByType(esInfo.Fields)
 
 	// 提取目标字段
-	fields := grpFileds[TypeText]
-
-	// 字段随机组合
-	cmbFields := utils.Combinations(fields, MaxCombine)
-	for _, cfs := range cmbFields {
-		ftd := &FuncTplData{
-			Name:    getDetailMatchFuncName(esInfo.StructName, cfs),
-			Comment: getDetailMatchFuncComment(esInfo.StructComment, cfs),
-			Params:  getDetailMatchFuncParams(cfs),
-			Query:   getDetailMatchMatchQuery(cfs),
-		}
-		funcDatas = append(funcDatas, ftd)
-	}
+	fields := esInfo.Spec.RoleFields(RoleMatch, grpFileds[TypeText])
 
-	return funcDatas
+	// 字段随机组合，每个检索函数同时生成高亮版本
+	collector := newFuncCollector(esInfo, FamilyMatch)
+	utils.EachCombination(fields, esInfo.Spec.CombineLimit(FamilyMatch, MaxCombine), func(cfs []*FieldInfo) bool {
+		if !esInfo.Spec.Allow(cfs) {
+			return true
+		}
+		collector.Add(cfs, func() *FuncTplData {
+			return &FuncTplData{
+				Name:    getDetailMatchFuncName(esInfo.StructName, cfs),
+				Comment: getDetailMatchFuncComment(esInfo.StructComment, cfs),
+				Params:  getDetailMatchFuncParams(cfs),
+				Query:   getDetailMatchMatchQuery(cfs),
+
+				Highlight: getDetailHighlightFields(cfs),
+			}
+		})
+		return true
+	})
+
+	return collector.Result()
 }
 
 // getDetailMatchFuncName 获取函数名称
@@ -95,73 +96,22 @@ func getDetailMatchMatchQuery(fields []*FieldInfo) string {
 	return fq
 }
 
-// GenEsDetailMatch 生成es检索详情
-func GenEsDetailMatch(outputPath string, esInfo *EsModelInfo) error {
-	// 预处理渲染所需的内容
-	funcData := PreDetailMatchCond(esInfo)
-	detailData := DetailTplData{
-		PackageName:   esInfo.PackageName,
-		StructName:    esInfo.StructName,
-		StructComment: esInfo.StructComment,
-		IndexName:     esInfo.IndexName,
-		FuncDatas:     funcData,
-	}
-
-	// 渲染
-	tmpl, err := template.New("structDatail").Parse(DetailTpl + DetailListTpl)
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, detailData)
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-
-	// 写入文件
-	outputPath = strings.Replace(outputPath, ".go", "_detail_match.go", -1)
-	err = os.WriteFile(outputPath, buf.Bytes(), 0644)
-	if err != nil {
-		return fmt.Errorf("Failed to write output file %s: %v", outputPath, err)
+// getDetailHighlightFields 获取需要高亮的字段列表
+func getDetailHighlightFields(fields []*FieldInfo) string {
+	fs := []string{}
+	for _, f := range fields {
+		fs = append(fs, fmt.Sprintf("\"%s\"", f.EsFieldPath))
 	}
-
-	// 调用go格式化工具格式化代码
-	cmd := exec.Command("goimports", "-w", outputPath)
-	cmd.Run()
-
-	return nil
+	return "[]string{" + strings.Join(fs, ", ") + "}"
 }
 
-// DetailTpl 检索详情代码模板
-const DetailTpl = `// Code generated by es2go. DO NOT EDIT.
-
-package {{.PackageName}}
-
-import (
-	"time"
-	"github.com/elastic/go-elasticsearch/v8"
-	eq "github.com/kyle-hy/esquery"
-)
+// DetailMatchGenerator 对text字段检索的生成器
+var DetailMatchGenerator = newBuiltinGenerator(FamilyMatch, "_detail_match", PreDetailMatchCond, "detail.tmpl")
 
-{{$in := .}}
-{{range $in.FuncDatas}}
-// {{.Name}} {{.Comment}}
-func {{.Name}}(es *elasticsearch.Client, {{.Params}}) (*eq.Data, *eq.Query, error) {
-	{{.Query}}
-	return queryBooksList(es, esQuery)
+// GenEsDetailMatch 生成es检索详情
+func GenEsDetailMatch(outputPath string, esInfo *EsModelInfo) error {
+	return Generate(DetailMatchGenerator, outputPath, esInfo)
 }
-{{end}}
-`
-
-// DetailListTpl 检索详情列表通用代码模板
-const DetailListTpl = `
-// 根据query条件查询{{$in.IndexName}}详细数据列表和总数量
-func query{{$in.StructName}}List (es *elasticsearch.Client, esQuery *eq.ESQuery) (*eq.Data, *eq.Query, error) {
-	l, t, err := eq.QueryList[{{$in.StructName}}](es, "{{$in.IndexName}}", esQuery)
-	if err != nil {
-		return nil, nil, err
-	}
 
-	data := &eq.Data{Detail: l, Total: t}
-	qinfo := &eq.Query{Index: "{{$in.IndexName}}", DSL: esQuery}
-	return data, qinfo, nil
-}
-`
+// DetailTpl 检索详情代码模板
+var DetailTpl = builtinTemplate("detail.tmpl")
@@ -1,12 +1,9 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
-	"os"
-	"os/exec"
+	"slices"
 	"strings"
-	"text/template"
 
 	"github.com/kyle-hy/es2go/utils"
 )
@@ -15,8 +12,6 @@ import (
 
 // PreDetailFilterCond 使用go代码预处理渲染需要的一些逻辑，template脚本出来调试困难
 func PreDetailFilterCond(esInfo *EsModelInfo) []*FuncTplData {
-	funcDatas := []*FuncTplData{}
-
 	// 按数据类型分组字段
 	grpFileds := GroupFieldsByType(esInfo.Fields)
 
@@ -24,23 +19,34 @@ func PreDetailFilterCond(esInfo *EsModelInfo) []*FuncTplData {
 	textFields := grpFileds[TypeText]       // 文本字段
 	keywordFields := grpFileds[TypeKeyword] // keyword字段
 	keywordFields = append(keywordFields, grpFileds[TypeNumber]...)
-
-	// 随机组合条件
-	cmbTextFields := utils.Combinations(textFields, 1)                  // 组合text字段
-	cmbFeywordFields := utils.Combinations(keywordFields, MaxCombine-1) // 随机组合keyword过滤条件
-	cmbFields := utils.CombineSlices(cmbFeywordFields, cmbTextFields)   // filter和match条件组合
-
-	for _, cfs := range cmbFields {
-		ftd := &FuncTplData{
-			Name:    getDetailFilterFuncName(esInfo.StructName, cfs),
-			Comment: getDetailFilterFuncComment(esInfo.StructComment, cfs),
-			Params:  getDetailFilterFuncParams(cfs),
-			Query:   getDetailFilterMatchQuery(cfs),
+	textFields = esInfo.Spec.RoleFields(RoleMatch, textFields)
+	keywordFields = esInfo.Spec.RoleFields(RoleFilter, keywordFields)
+
+	// 随机组合keyword过滤条件，再与text字段组合，每个检索函数同时生成高亮版本
+	collector := newFuncCollector(esInfo, FamilyFilter)
+	maxCombine := esInfo.Spec.CombineLimit(FamilyFilter, MaxCombine)
+	cmbTextFields := utils.Combinations(textFields, 1) // 组合text字段
+	utils.EachCombination(keywordFields, maxCombine-1, func(kfs []*FieldInfo) bool {
+		for _, tfs := range cmbTextFields {
+			cfs := [][]*FieldInfo{kfs, tfs} // filter和match条件组合
+			if !esInfo.Spec.Allow(cfs...) {
+				continue
+			}
+			collector.Add(append(slices.Clone(kfs), tfs...), func() *FuncTplData {
+				return &FuncTplData{
+					Name:    getDetailFilterFuncName(esInfo.StructName, cfs),
+					Comment: getDetailFilterFuncComment(esInfo.StructComment, cfs),
+					Params:  getDetailFilterFuncParams(cfs),
+					Query:   getDetailFilterMatchQuery(cfs),
+
+					Highlight: getDetailHighlightFields(tfs),
+				}
+			})
 		}
-		funcDatas = append(funcDatas, ftd)
-	}
+		return true
+	})
 
-	return funcDatas
+	return collector.Result()
 }
 
 // getDetailFilterFuncName 获取函数名称
@@ -131,38 +137,10 @@ func getDetailFilterMatchQuery(fields [][]*FieldInfo) string {
 	return fq
 }
 
+// DetailFilterGenerator 以keyword字段过滤后对text字段检索的生成器
+var DetailFilterGenerator = newBuiltinGenerator(FamilyFilter, "_detail_match_filter", PreDetailFilterCond, "detail.tmpl")
+
 // GenEsDetailFilter 生成es检索详情
 func GenEsDetailFilter(outputPath string, esInfo *EsModelInfo) error {
-	// 预处理渲染所需的内容
-	funcData := PreDetailFilterCond(esInfo)
-
-	detailData := DetailTplData{
-		PackageName:   esInfo.PackageName,
-		StructName:    esInfo.StructName,
-		StructComment: esInfo.StructComment,
-		IndexName:     esInfo.IndexName,
-		FuncDatas:     funcData,
-	}
-
-	// 渲染
-	tmpl, err := template.New("structDatail").Parse(DetailTpl)
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, detailData)
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-
-	// 写入文件
-	outputPath = strings.Replace(outputPath, ".go", "_detail_match_filter.go", -1)
-	err = os.WriteFile(outputPath, buf.Bytes(), 0644)
-	if err != nil {
-		return fmt.Errorf("Failed to write output file %s: %v", outputPath, err)
-	}
-
-	// 调用go格式化工具格式化代码
-	cmd := exec.Command("goimports", "-w", outputPath)
-	cmd.Run()
-
-	return nil
+	return Generate(DetailFilterGenerator, outputPath, esInfo)
 }
@@ -1,12 +1,8 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
-	"text/template"
 
 	"github.com/kyle-hy/es2go/utils"
 )
@@ -15,33 +11,36 @@ import (
 
 // PreDetailRangeCond 使用go代码预处理渲染需要的一些逻辑，template脚本调试困难
 func PreDetailRangeCond(esInfo *EsModelInfo) []*FuncTplData {
-	funcDatas := []*FuncTplData{}
-
 	// 按数据类型分组字段
 	grpFileds := GroupFieldsByType(esInfo.Fields)
 
 	// 提取目标字段
-	fields := grpFileds[TypeNumber]
+	fields := esInfo.Spec.RoleFields(RoleRange, grpFileds[TypeNumber])
 
 	// 字段随机组合
-	cmbFields := utils.Combinations(fields, 2) // 范围查询比较方式较多，限定到两个字段的组合
-	for _, cfs := range cmbFields {
+	collector := newFuncCollector(esInfo, FamilyRange)
+	utils.EachCombination(fields, esInfo.Spec.CombineLimit(FamilyRange, MaxRangeCombine), func(cfs []*FieldInfo) bool {
+		if !esInfo.Spec.Allow(cfs) {
+			return true
+		}
 		names := getDetailRangeFuncName(esInfo.StructName, cfs)
 		comments := getDetailRangeFuncComment(esInfo.StructName, cfs)
 		params := getDetailRangeFuncParams(cfs)
 		queries := getDetailRangeMatchQuery(cfs)
 		for idx := range len(names) {
-			ftd := &FuncTplData{
-				Name:    names[idx],
-				Comment: comments[idx],
-				Params:  params[idx],
-				Query:   queries[idx],
-			}
-			funcDatas = append(funcDatas, ftd)
+			collector.Add(cfs, func() *FuncTplData {
+				return &FuncTplData{
+					Name:    names[idx],
+					Comment: comments[idx],
+					Params:  params[idx],
+					Query:   queries[idx],
+				}
+			})
 		}
-	}
+		return true
+	})
 
-	return funcDatas
+	return collector.Result()
 }
 
 // 数值比较操作
@@ -85,7 +84,6 @@ func getDetailRangeFuncName(structName string, fields []*FieldInfo) []string {
 	for _, fopt := range fopts {
 		names = append(names, fn+fopt)
 	}
-	utils.JPrint(names)
 	return names
 }
 
@@ -110,7 +108,6 @@ func getDetailRangeFuncComment(structComment string, fields []*FieldInfo) []stri
 	for _, fopt := range fopts {
 		funcCmts = append(funcCmts, fn+fopt+"指定数值的详细数据列表和总数量\n")
 	}
-	// utils.JPrint(funcCmts)
 
 	// 参数注释部分
 	fieldParamCmts := [][]string{}
@@ -134,8 +131,6 @@ func getDetailRangeFuncComment(structComment string, fields []*FieldInfo) []stri
 		}
 	}
 
-	utils.JPrint(funcCmts)
-
 	return funcCmts
 }
 
@@ -158,7 +153,6 @@ func getDetailRangeFuncParams(fields []*FieldInfo) []string {
 	for idx, fp := range funcParams {
 		funcParams[idx] = strings.TrimSuffix(fp, ", ")
 	}
-	utils.JPrint(funcParams)
 	return funcParams
 }
 
@@ -194,42 +188,13 @@ func getDetailRangeMatchQuery(fields []*FieldInfo) []string {
 		fq += `	esQuery := &eq.ESQuery{Query: eq.Bool(eq.WithFilter(ranges))}`
 		funcRanges[idx] = fq
 	}
-	utils.JPrint(funcRanges)
-
 	return funcRanges
 }
 
+// DetailRangeGenerator 数值范围查询的生成器
+var DetailRangeGenerator = newBuiltinGenerator(FamilyRange, "_detail_range", PreDetailRangeCond, "detail.tmpl")
+
 // GenEsDetailRange 生成es检索详情
 func GenEsDetailRange(outputPath string, esInfo *EsModelInfo) error {
-	// 预处理渲染所需的内容
-	funcData := PreDetailRangeCond(esInfo)
-	detailData := DetailTplData{
-		PackageName:   esInfo.PackageName,
-		StructName:    esInfo.StructName,
-		StructComment: esInfo.StructComment,
-		IndexName:     esInfo.IndexName,
-		FuncDatas:     funcData,
-	}
-
-	// 渲染
-	tmpl, err := template.New("structDatail").Parse(DetailTpl)
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, detailData)
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-
-	// 写入文件
-	outputPath = strings.Replace(outputPath, ".go", "_detail_range.go", -1)
-	err = os.WriteFile(outputPath, buf.Bytes(), 0644)
-	if err != nil {
-		return fmt.Errorf("Failed to write output file %s: %v", outputPath, err)
-	}
-
-	// 调用go格式化工具格式化代码
-	cmd := exec.Command("goimports", "-w", outputPath)
-	cmd.Run()
-
-	return nil
+	return Generate(DetailRangeGenerator, outputPath, esInfo)
 }
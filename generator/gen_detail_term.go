@@ -1,12 +1,8 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
-	"text/template"
 
 	"github.com/kyle-hy/es2go/utils"
 )
@@ -15,28 +11,32 @@ import (
 
 // PreDetailTermCond 使用go代码预处理渲染需要的一些逻辑，template脚本出来调试困难
 func PreDetailTermCond(esInfo *EsModelInfo) []*FuncTplData {
-	funcDatas := []*FuncTplData{}
-
 	// 按数据类型分组字段
 	grpFileds := GroupFieldsByType(esInfo.Fields)
 
 	// 提取目标字段
 	fields := grpFileds[TypeKeyword]                  // keyword字段
 	fields = append(fields, grpFileds[TypeNumber]...) // 数值
+	fields = esInfo.Spec.RoleFields(RoleFilter, fields)
 
 	// 字段随机组合
-	cmbFields := utils.Combinations(fields, MaxCombine)
-	for _, cfs := range cmbFields {
-		ftd := &FuncTplData{
-			Name:    getDetailTermFuncName(esInfo.StructName, cfs),
-			Comment: getDetailTermFuncComment(esInfo.StructComment, cfs),
-			Params:  getDetailTermFuncParams(cfs),
-			Query:   getDetailTermMatchQuery(cfs),
+	collector := newFuncCollector(esInfo, FamilyTerm)
+	utils.EachCombination(fields, esInfo.Spec.CombineLimit(FamilyTerm, MaxCombine), func(cfs []*FieldInfo) bool {
+		if !esInfo.Spec.Allow(cfs) {
+			return true
 		}
-		funcDatas = append(funcDatas, ftd)
-	}
-
-	return funcDatas
+		collector.Add(cfs, func() *FuncTplData {
+			return &FuncTplData{
+				Name:    getDetailTermFuncName(esInfo.StructName, cfs),
+				Comment: getDetailTermFuncComment(esInfo.StructComment, cfs),
+				Params:  getDetailTermFuncParams(cfs),
+				Query:   getDetailTermMatchQuery(cfs),
+			}
+		})
+		return true
+	})
+
+	return collector.Result()
 }
 
 // getDetailTermFuncName 获取函数名称
@@ -96,37 +96,10 @@ func getDetailTermMatchQuery(fields []*FieldInfo) string {
 	return fq
 }
 
+// DetailTermGenerator keyword字段精确查询的生成器
+var DetailTermGenerator = newBuiltinGenerator(FamilyTerm, "_detail_term", PreDetailTermCond, "detail.tmpl")
+
 // GenEsDetailTerm 生成es检索详情
 func GenEsDetailTerm(outputPath string, esInfo *EsModelInfo) error {
-	// 预处理渲染所需的内容
-	funcData := PreDetailTermCond(esInfo)
-	detailData := DetailTplData{
-		PackageName:   esInfo.PackageName,
-		StructName:    esInfo.StructName,
-		StructComment: esInfo.StructComment,
-		IndexName:     esInfo.IndexName,
-		FuncDatas:     funcData,
-	}
-
-	// 渲染
-	tmpl, err := template.New("structDatail").Parse(DetailTpl)
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, detailData)
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-
-	// 写入文件
-	outputPath = strings.Replace(outputPath, ".go", "_detail_term.go", -1)
-	err = os.WriteFile(outputPath, buf.Bytes(), 0644)
-	if err != nil {
-		return fmt.Errorf("Failed to write output file %s: %v", outputPath, err)
-	}
-
-	// 调用go格式化工具格式化代码
-	cmd := exec.Command("goimports", "-w", outputPath)
-	cmd.Run()
-
-	return nil
+	return Generate(DetailTermGenerator, outputPath, esInfo)
 }
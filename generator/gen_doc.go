@@ -0,0 +1,191 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// 在输出目录生成doc.go，列出目录中es2go生成的文件及其包含的类型和函数数量。
+// 同一目录可能存放多个模型的代码，因此按目录中已有的生成文件汇总，而不只是本次生成的文件
+
+// generatedHeader es2go生成文件的首行，用于识别目录中的生成文件
+const generatedHeader = "// Code generated by es2go. DO NOT EDIT."
+
+// docFileName 列出目录内容的文件名
+const docFileName = "doc.go"
+
+// GenEsDocs 生成模型和查询函数所在目录的doc.go，内容未变化时不重写
+func GenEsDocs(outputPath string, esInfo *EsModelInfo) error {
+	docs, err := RenderDocs(outputPath, esInfo, nil)
+	if err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		old, err := os.ReadFile(doc.Path)
+		if err == nil && bytes.Equal(old, doc.Source) {
+			continue
+		}
+		err = doc.Write()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenderDocs 渲染模型和查询函数所在目录的doc.go，不写入文件。
+// files为本次渲染尚未写入的文件，优先于磁盘上的同名文件
+func RenderDocs(outputPath string, esInfo *EsModelInfo, files []*OutputFile) ([]*OutputFile, error) {
+	dirs := []string{esInfo.Layout.ModelDir(outputPath)}
+	if queryDir := esInfo.Layout.QueryDir(outputPath); queryDir != dirs[0] {
+		dirs = append(dirs, queryDir)
+	}
+
+	overlay := map[string]map[string][]byte{}
+	for _, dir := range dirs {
+		overlay[filepath.Clean(dir)] = map[string][]byte{}
+	}
+	for _, f := range files {
+		if names, ok := overlay[filepath.Dir(f.Path)]; ok {
+			names[filepath.Base(f.Path)] = f.Source
+		}
+	}
+
+	tmpl, err := loadTemplate("doc", esInfo.TmplDir, []string{"doc.tmpl"}, DocTpl)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := []*OutputFile{}
+	for _, dir := range dirs {
+		doc, err := renderDoc(tmpl, dir, overlay[filepath.Clean(dir)])
+		if err != nil {
+			return nil, err
+		}
+		if doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+// renderDoc 渲染一个目录的doc.go，目录中没有生成文件时返回nil
+func renderDoc(tmpl *template.Template, dir string, overlay map[string][]byte) (*OutputFile, error) {
+	sources, err := generatedSources(dir, overlay)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := DocTplData{}
+	for _, name := range names {
+		decls, err := parseDecls(name, sources[name])
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse generated file %s: %v", filepath.Join(dir, name), err)
+		}
+		if data.PackageName == "" {
+			data.PackageName = decls.Package
+		}
+		data.Files = append(data.Files, &DocFileInfo{Name: name, Types: len(decls.Types), Funcs: len(decls.Funcs) + decls.Methods})
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing doc template: %v", err)
+	}
+	return newOutputFile("doc", filepath.Join(dir, docFileName), buf.Bytes(), false)
+}
+
+// generatedSources 收集目录中es2go生成的代码文件，overlay中的文件优先于磁盘上的文件。
+// 已存在的doc.go不是es2go生成时返回错误，避免覆盖手写的包注释
+func generatedSources(dir string, overlay map[string][]byte) (map[string][]byte, error) {
+	sources := map[string][]byte{}
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("Failed to read directory %s: %v", dir, err)
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if _, ok := overlay[name]; ok {
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read file %s: %v", filepath.Join(dir, name), err)
+		}
+		if !bytes.HasPrefix(src, []byte(generatedHeader)) {
+			if name == docFileName {
+				return nil, fmt.Errorf("%s was not generated by es2go, remove it or disable doc generation", filepath.Join(dir, name))
+			}
+			continue
+		}
+		sources[name] = src
+	}
+	for name, src := range overlay {
+		sources[name] = src
+	}
+	delete(sources, docFileName)
+	return sources, nil
+}
+
+// fileDecls 代码文件的包名和顶层声明
+type fileDecls struct {
+	Package string   // 包名
+	Types   []string // 类型名称
+	Funcs   []string // 函数名称，不含方法
+	Methods int      // 方法数量
+}
+
+// parseDecls 解析代码文件的顶层类型和函数声明
+func parseDecls(filename string, src []byte) (*fileDecls, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	decls := &fileDecls{Package: file.Name.Name}
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv != nil {
+				decls.Methods++
+				continue
+			}
+			decls.Funcs = append(decls.Funcs, d.Name.Name)
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				decls.Types = append(decls.Types, spec.(*ast.TypeSpec).Name.Name)
+			}
+		}
+	}
+	return decls, nil
+}
+
+// DocTpl doc.go的代码模板
+var DocTpl = builtinTemplate("doc.tmpl")
@@ -3,10 +3,10 @@ package generator
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -24,98 +24,198 @@ type GenOptions struct {
 	SkipFieldPath      *string
 	FieldCommentPath   *string
 	TmplPath           *string
+	TmplDir            *string
+	SpecPath           *string
+	StatsPath          *string
+	Spec               *GenSpec     // 生成规格，未指定SpecPath时使用
+	Config             *Config      // 映射配置，指定时作为基础配置，各映射配置文件覆盖对应的项，生成过程只读取不修改
+	Layout             *Layout      // 输出文件的布局，为空时全部文件输出到模型文件所在的目录
+	SkipWrite          bool         // 只解析mapping并渲染model代码，不写入文件
+	Logger             *slog.Logger // 生成过程的日志，为空时使用slog.Default()
 }
 
-// GoTypeMap holds the mapping from Elasticsearch types to Go types.
-var (
+// logger 生成过程的日志，未指定时使用slog.Default()
+func (o *GenOptions) logger() *slog.Logger {
+	if o == nil || o.Logger == nil {
+		return slog.Default()
+	}
+	return o.Logger
+}
+
+// Config 生成模型结构体的映射配置，每次生成使用独立的值，多个索引可以在同一进程中并发生成
+type Config struct {
 	GoTypeMap       map[string]string // es字段与go字段的映射
 	FieldExceptions map[string]string // 异常字段
 	TypeExceptions  map[string]string // 异常类型
 	SkipFields      map[string]bool   // 忽略的字段
 	FieldComments   map[string]string // 字段注释
-)
+}
 
-// StructNameTracker 用于避免生成重复的结构体名称
-var StructNameTracker map[string]bool
+// DefaultGoTypeMap 默认的es类型与go类型的映射，每次调用返回新的map
+func DefaultGoTypeMap() map[string]string {
+	return map[string]string{
+		"integer":   "int64",
+		"long":      "int64",
+		"float":     "float64",
+		"boolean":   "bool",
+		"text":      "string",
+		"keyword":   "string",
+		"date":      "time.Time",
+		"geo_point": "[]float64",
+		"object":    "map[string]any",
+		"nested":    "[]any",
+	}
+}
 
-// GenEsModel 生成es表属性的model
-func GenEsModel(inputPath, outputPath, packageName, structName string, opts *GenOptions) (*EsModelInfo, error) {
-	// check for required fields
-	if inputPath == "" || outputPath == "" || structName == "" || packageName == "" {
-		return nil, fmt.Errorf("inputPath, outputPath, structName, and packageName must be specified")
+// newConfig 根据选项加载映射配置，未指定的配置使用opts.Config或默认值，各配置文件的错误合并后返回
+func newConfig(opts *GenOptions) (*Config, error) {
+	cfg := &Config{
+		GoTypeMap:       DefaultGoTypeMap(),
+		FieldExceptions: map[string]string{},
+		TypeExceptions:  map[string]string{},
+		SkipFields:      map[string]bool{},
+		FieldComments:   map[string]string{},
+	}
+	if opts == nil {
+		return cfg, nil
+	}
+	if opts.Config != nil {
+		base := *opts.Config
+		cfg = &base
 	}
 
-	// initialize StructNameTracker
-	StructNameTracker = make(map[string]bool)
+	var err error
+	errs := []error{}
 
 	// load custom type mapping if provided
-	if opts != nil && opts.TypeMappingPath != nil && *opts.TypeMappingPath != "" {
-		loadTypeMapping(*opts.TypeMappingPath)
-	} else {
-		// default mapping
-		GoTypeMap = map[string]string{
-			"integer":   "int64",
-			"long":      "int64",
-			"float":     "float64",
-			"boolean":   "bool",
-			"text":      "string",
-			"keyword":   "string",
-			"date":      "time.Time",
-			"geo_point": "[]float64",
-			"object":    "map[string]any",
-			"nested":    "[]any",
-		}
+	if opts.TypeMappingPath != nil && *opts.TypeMappingPath != "" {
+		cfg.GoTypeMap, err = loadTypeMapping(*opts.TypeMappingPath)
+		errs = append(errs, err)
 	}
 
 	// load field exceptions if provided
-	if opts != nil && opts.ExceptionFieldPath != nil && *opts.ExceptionFieldPath != "" {
-		loadFieldExceptions(*opts.ExceptionFieldPath)
-	} else {
-		FieldExceptions = make(map[string]string)
+	if opts.ExceptionFieldPath != nil && *opts.ExceptionFieldPath != "" {
+		cfg.FieldExceptions, err = loadFieldExceptions(*opts.ExceptionFieldPath)
+		errs = append(errs, err)
 	}
 
 	// load type exceptions if provided
-	if opts != nil && opts.ExceptionTypePath != nil && *opts.ExceptionTypePath != "" {
-		loadTypeExceptions(*opts.ExceptionTypePath)
-	} else {
-		TypeExceptions = make(map[string]string)
+	if opts.ExceptionTypePath != nil && *opts.ExceptionTypePath != "" {
+		cfg.TypeExceptions, err = loadTypeExceptions(*opts.ExceptionTypePath)
+		errs = append(errs, err)
 	}
 
 	// load skip fields if provided
-	if opts != nil && opts.SkipFieldPath != nil && *opts.SkipFieldPath != "" {
-		loadSkipFields(*opts.SkipFieldPath)
-	} else {
-		SkipFields = make(map[string]bool)
+	if opts.SkipFieldPath != nil && *opts.SkipFieldPath != "" {
+		cfg.SkipFields, err = loadSkipFields(*opts.SkipFieldPath)
+		errs = append(errs, err)
 	}
 
 	// load field comments if provided
-	if opts != nil && opts.FieldCommentPath != nil && *opts.FieldCommentPath != "" {
-		loadFieldComments(*opts.FieldCommentPath)
-	} else {
-		FieldComments = make(map[string]string)
+	if opts.FieldCommentPath != nil && *opts.FieldCommentPath != "" {
+		cfg.FieldComments, err = loadFieldComments(*opts.FieldCommentPath)
+		errs = append(errs, err)
+	}
+	return cfg, errors.Join(errs...)
+}
+
+// structBuilder 生成一个模型的结构体定义，记录已生成的结构体名称以避免重复
+type structBuilder struct {
+	cfg         *Config
+	structNames map[string]bool // 已生成的结构体名称
+}
+
+// newStructBuilder 创建使用指定映射配置的结构体生成器
+func newStructBuilder(cfg *Config) *structBuilder {
+	return &structBuilder{cfg: cfg, structNames: map[string]bool{}}
+}
+
+// GenEsModel 生成es表属性的model
+func GenEsModel(inputPath, outputPath, packageName, structName string, opts *GenOptions) (*EsModelInfo, error) {
+	// check for required fields
+	if inputPath == "" || outputPath == "" || structName == "" || packageName == "" {
+		return nil, fmt.Errorf("inputPath, outputPath, structName, and packageName must be specified")
+	}
+
+	// 加载映射配置
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
 	}
 
 	// load custom template if provided
 	var tmpl *template.Template
-	var err error
+	var tmplDir string
+	if opts != nil && opts.TmplDir != nil {
+		tmplDir = *opts.TmplDir
+	}
 	if opts != nil && opts.TmplPath != nil && *opts.TmplPath != "" {
-		tmpl, err = template.ParseFiles(*opts.TmplPath)
+		tmpl, err = template.New(filepath.Base(*opts.TmplPath)).Funcs(TemplateFuncs).ParseFiles(*opts.TmplPath)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to load template file %s: %v", *opts.TmplPath, err)
 		}
 	} else {
 		// choose default template based on the presence of InitClassName
 		if opts != nil && opts.InitClassName != nil && *opts.InitClassName != "" {
-			tmpl, err = template.New("structWithWrapper").Parse(StructTplWithWrapper)
+			tmpl, err = loadTemplate("structWithWrapper", tmplDir, []string{"struct_wrapper.tmpl"}, StructTplWithWrapper)
 		} else {
-			tmpl, err = template.New("structWithoutWrapper").Parse(StructTplWithoutWrapper)
+			tmpl, err = loadTemplate("structWithoutWrapper", tmplDir, []string{"struct.tmpl"}, StructTplWithoutWrapper)
 		}
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing template: %v", err)
+			return nil, err
 		}
 	}
 
-	return processFile(inputPath, outputPath, packageName, structName, opts, tmpl)
+	// load generation spec if provided
+	var spec *GenSpec
+	if opts != nil && opts.SpecPath != nil && *opts.SpecPath != "" {
+		spec, err = LoadGenSpec(*opts.SpecPath)
+		if err != nil {
+			return nil, err
+		}
+	} else if opts != nil && opts.Spec != nil {
+		// 预算可能修改max_funcs，复制后使用以免影响其他索引
+		spec = opts.Spec.clone()
+	}
+
+	// load field stats if provided
+	var stats FieldStats
+	if opts != nil && opts.StatsPath != nil && *opts.StatsPath != "" {
+		stats, err = LoadFieldStats(*opts.StatsPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 输出文件的布局
+	layout := &Layout{}
+	if opts != nil && opts.Layout != nil {
+		*layout = *opts.Layout
+	}
+	err = layout.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = layout.resolveModelImport(outputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	esModelInfo, err := processFile(inputPath, layout.ModelPath(outputPath), packageName, structName, opts, cfg, tmpl)
+	if err != nil {
+		return nil, err
+	}
+	esModelInfo.Layout = layout
+	esModelInfo.Spec = spec
+	esModelInfo.Logger = opts.logger().With("model", structName)
+	esModelInfo.TmplDir = tmplDir
+
+	// 字段重要性评分
+	err = RankFields(esModelInfo.Fields, stats)
+	if err != nil {
+		return nil, err
+	}
+	return esModelInfo, nil
 }
 
 // RemoveExt 删除文件的后缀名
@@ -124,60 +224,60 @@ func RemoveExt(path string) string {
 	return path[:len(path)-len(ext)]
 }
 
-func processFile(inputPath, outputPath, packageName, structName string, opts *GenOptions, tmpl *template.Template) (*EsModelInfo, error) {
+func processFile(inputPath, outputPath, packageName, structName string, opts *GenOptions, cfg *Config, tmpl *template.Template) (*EsModelInfo, error) {
 	data, err := os.ReadFile(inputPath)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to read file %s: %v", inputPath, err)
 	}
 
-	var esMapping ElasticsearchMapping
-	err = json.Unmarshal(data, &esMapping)
-	if err != nil {
-		return nil, fmt.Errorf("Error unmarshalling JSON from file %s: %v", inputPath, err)
-	}
-
-	fields, structDefinitions := generateStructDefinitions(structName, esMapping.Mappings.Meta, esMapping.Mappings.Properties, "")
-
 	var initClassName string
 	if opts != nil && opts.InitClassName != nil {
 		initClassName = *opts.InitClassName
 	}
 
-	structData := StructTplData{
-		PackageName:       packageName,
-		InitClassName:     initClassName,
-		StructName:        structName,
-		StructDefinitions: structDefinitions,
-	}
+	// 从mapping文件名提取es索引名称
+	indexName := RemoveExt(filepath.Base(inputPath))
+	indexName = strings.TrimSuffix(indexName, "_mapping") // 尝试删除索引文件添加的后缀
+	indexName = strings.TrimSuffix(indexName, "-mapping") // 尝试删除索引文件添加的后缀
 
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, structData)
+	esModelInfo, err := parseMappingData("file "+inputPath, data, indexName, packageName, structName, initClassName, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("Error executing template: %v", err)
+		return nil, err
 	}
-
-	err = os.WriteFile(outputPath, buf.Bytes(), 0644)
+	esModelInfo.ModelPath = outputPath
+	esModelInfo.ModelSource, err = renderModel(esModelInfo, tmpl)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to write output file %s: %v", outputPath, err)
+		return nil, err
 	}
 
-	// 调用go格式化工具格式化代码
-	cmd := exec.Command("goimports", "-w", outputPath)
-	cmd.Run()
+	if opts == nil || !opts.SkipWrite {
+		err = (&OutputFile{Path: outputPath, Generator: "model", Source: esModelInfo.ModelSource}).Write()
+		if err != nil {
+			return nil, err
+		}
 
-	fmt.Printf("Generated Go struct for %s and saved to %s\n", inputPath, outputPath)
+		opts.logger().Info("Generated Go struct", "input", inputPath, "output", outputPath)
+	}
+	return esModelInfo, nil
+}
 
-	// 从mapping文件名提取es索引名称
-	indexName := RemoveExt(filepath.Base(inputPath))
-	indexName = strings.TrimSuffix(indexName, "_mapping") // 尝试删除索引文件添加的后缀
-	indexName = strings.TrimSuffix(indexName, "-mapping") // 尝试删除索引文件添加的后缀
+// parseMappingData 解析mapping的内容生成字段信息和结构体定义，source用于在错误信息中定位mapping
+func parseMappingData(source string, data []byte, indexName, packageName, structName, initClassName string, cfg *Config) (*EsModelInfo, error) {
+	var esMapping ElasticsearchMapping
+	err := json.Unmarshal(data, &esMapping)
+	if err != nil {
+		return nil, fmt.Errorf("Error unmarshalling JSON from %s: %w", jsonErrorPos(source, data, err), err)
+	}
+
+	fields, structDefinitions := newStructBuilder(cfg).generateStructDefinitions(structName, esMapping.Mappings.Meta, esMapping.Mappings.Properties, "", "")
 	esModelInfo := &EsModelInfo{
-		PackageName:   packageName,
-		InitClassName: initClassName,
-		StructName:    structName,
-		IndexName:     indexName,
-		StructComment: esMapping.Mappings.Meta.Comment,
-		Fields:        fields,
+		PackageName:       packageName,
+		InitClassName:     initClassName,
+		StructName:        structName,
+		IndexName:         indexName,
+		StructComment:     esMapping.Mappings.Meta.Comment,
+		StructDefinitions: structDefinitions,
+		Fields:            fields,
 	}
 	if esModelInfo.StructComment == "" {
 		esModelInfo.StructComment = indexName
@@ -185,43 +285,65 @@ func processFile(inputPath, outputPath, packageName, structName string, opts *Ge
 	return esModelInfo, nil
 }
 
-// generateStructDefinitions 生成模型结构体定义的字段信息
-func generateStructDefinitions(structName string, meta Meta, properties map[string]Property, nestedPath string) ([]*FieldInfo, string) {
+// renderModel 使用模板渲染模型结构体的代码并格式化
+func renderModel(esInfo *EsModelInfo, tmpl *template.Template) ([]byte, error) {
+	structData := StructTplData{
+		PackageName:       esInfo.PackageName,
+		InitClassName:     esInfo.InitClassName,
+		StructName:        esInfo.StructName,
+		StructDefinitions: esInfo.StructDefinitions,
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, structData)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing template: %v", err)
+	}
+
+	// 格式化代码
+	return formatSource("model", esInfo.ModelPath, buf.Bytes(), esInfo.formatOnly)
+}
+
+// generateStructDefinitions 生成模型结构体定义的字段信息，parentPath为上级对象的完整路径，nestedPath为嵌套对象的字段名
+func (b *structBuilder) generateStructDefinitions(structName string, meta Meta, properties map[string]Property, parentPath, nestedPath string) ([]*FieldInfo, string) {
 	var structDefs strings.Builder
-	fields := generateStruct(&structDefs, structName, meta, properties)
+	fields := b.generateStruct(&structDefs, structName, meta, properties, joinFieldPath(parentPath, nestedPath))
 	if nestedPath != "" {
 		AddNestedFilePath(nestedPath, fields)
 	}
 	return fields, structDefs.String()
 }
 
-func generateStruct(structDefs *strings.Builder, structName string, meta Meta, properties map[string]Property) []*FieldInfo {
+func (b *structBuilder) generateStruct(structDefs *strings.Builder, structName string, meta Meta, properties map[string]Property, structPath string) []*FieldInfo {
 	// check if the struct has already been generated
-	if _, exists := StructNameTracker[structName]; exists {
+	if _, exists := b.structNames[structName]; exists {
 		return nil
 	}
 
 	// mark this struct as generated
-	StructNameTracker[structName] = true
+	b.structNames[structName] = true
 
 	fields := []*FieldInfo{}
 	allFields := []*FieldInfo{}
 	nestedStructs := []string{}
 
 	for name, prop := range properties {
+		// 按完整路径查找配置，如menu_items.items.price
+		fieldPath := joinFieldPath(structPath, name)
+
 		// skip fields that are in the SkipFields map
-		if _, skip := SkipFields[name]; skip {
+		if skip, _ := lookupField(b.cfg.SkipFields, fieldPath, name); skip {
 			continue
 		}
 
-		fieldName := mapElasticsearchFieldToGoField(name)
+		fieldName := b.mapElasticsearchFieldToGoField(fieldPath, name)
 		var fieldType string
 		var fieldsKeyword string
 		fieldComment := prop.Meta.Comment
 
 		if prop.Type == "object" || prop.Type == "nested" {
 			// check if the type has a custom exception
-			if customType, exists := TypeExceptions[name]; exists {
+			if customType, exists := lookupField(b.cfg.TypeExceptions, fieldPath, name); exists {
 				var nestedStructName string
 				fieldType = customType
 				if strings.HasPrefix(fieldType, "*") {
@@ -232,7 +354,7 @@ func generateStruct(structDefs *strings.Builder, structName string, meta Meta, p
 					nestedStructName = fieldType
 				}
 
-				nestedFields, structDefine := generateStructDefinitions(nestedStructName, prop.Meta, prop.Properties, name)
+				nestedFields, structDefine := b.generateStructDefinitions(nestedStructName, prop.Meta, prop.Properties, structPath, name)
 				nestedStructs = append(nestedStructs, structDefine)
 
 				// AddNestedFilePath(name, nestedFields)
@@ -241,19 +363,19 @@ func generateStruct(structDefs *strings.Builder, structName string, meta Meta, p
 				nestedStructName := utils.ToPascalCase(name)
 				fieldType = "*" + nestedStructName
 
-				nestedFields, structDefine := generateStructDefinitions(nestedStructName, prop.Meta, prop.Properties, name)
+				nestedFields, structDefine := b.generateStructDefinitions(nestedStructName, prop.Meta, prop.Properties, structPath, name)
 				nestedStructs = append(nestedStructs, structDefine)
 
 				// AddNestedFilePath(name, nestedFields)
 				allFields = append(allFields, nestedFields...)
 			}
 		} else {
-			fieldType = mapElasticsearchTypeToGoType(name, prop.Type)
+			fieldType = b.mapElasticsearchTypeToGoType(fieldPath, name, prop.Type)
 			fieldsKeyword = prop.Fields.Keyword.Type
 		}
 
 		// 以配置文件为准
-		comment := mapElasticsearchFieldToComment(name)
+		comment := b.mapElasticsearchFieldToComment(fieldPath, name)
 		if comment != "" {
 			fieldComment = comment
 		}
@@ -270,6 +392,9 @@ func generateStruct(structDefs *strings.Builder, structName string, meta Meta, p
 			JSONName:      name,
 			FieldComment:  fieldComment,
 			FieldsKeyword: fieldsKeyword,
+			Cardinality:   prop.Meta.Cardinality,
+			Priority:      prop.Meta.Priority,
+			Synonyms:      parseSynonyms(prop.Meta.Synonyms),
 		}
 		fields = append(fields, finfo)
 		allFields = append(allFields, finfo)
@@ -319,13 +444,13 @@ func generateStruct(structDefs *strings.Builder, structName string, meta Meta, p
 	return allFields
 }
 
-func mapElasticsearchTypeToGoType(name, esType string) string {
+func (b *structBuilder) mapElasticsearchTypeToGoType(fieldPath, name, esType string) string {
 	// check if the type has a custom exception
-	if customType, exists := TypeExceptions[name]; exists {
+	if customType, exists := lookupField(b.cfg.TypeExceptions, fieldPath, name); exists {
 		return customType
 	}
 
-	goType, exists := GoTypeMap[esType]
+	goType, exists := b.cfg.GoTypeMap[esType]
 	if !exists {
 		goType = "any"
 	}
@@ -340,82 +465,92 @@ func AddNestedFilePath(nestedName string, fields []*FieldInfo) {
 	}
 }
 
-func mapElasticsearchFieldToGoField(esFieldName string) string {
+func (b *structBuilder) mapElasticsearchFieldToGoField(fieldPath, esFieldName string) string {
 	// check if the field has a custom exception
-	if customFieldName, exists := FieldExceptions[esFieldName]; exists {
+	if customFieldName, exists := lookupField(b.cfg.FieldExceptions, fieldPath, esFieldName); exists {
 		return customFieldName
 	}
 
 	return utils.ToPascalCase(esFieldName)
 }
 
-func mapElasticsearchFieldToComment(esFieldName string) string {
+func (b *structBuilder) mapElasticsearchFieldToComment(fieldPath, esFieldName string) string {
 	// check if the field has a custom comment
-	if comment, exists := FieldComments[esFieldName]; exists {
+	if comment, exists := lookupField(b.cfg.FieldComments, fieldPath, esFieldName); exists {
 		return comment
 	}
 
 	return ""
 }
 
-func loadTypeMapping(filePath string) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		log.Fatalf("Failed to read type mapping file %s: %v", filePath, err)
-	}
-
-	err = json.Unmarshal(data, &GoTypeMap)
-	if err != nil {
-		log.Fatalf("Error unmarshalling JSON from type mapping file %s: %v", filePath, err)
-	}
+func loadTypeMapping(filePath string) (map[string]string, error) {
+	m := map[string]string{}
+	err := loadJSONFile("type mapping", filePath, &m)
+	return m, err
 }
 
-func loadFieldExceptions(filePath string) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		log.Fatalf("Failed to read field exception file %s: %v", filePath, err)
-	}
+func loadFieldExceptions(filePath string) (map[string]string, error) {
+	m := map[string]string{}
+	err := loadJSONFile("field exception", filePath, &m)
+	return m, err
+}
 
-	err = json.Unmarshal(data, &FieldExceptions)
-	if err != nil {
-		log.Fatalf("Error unmarshalling JSON from field exception file %s: %v", filePath, err)
-	}
+func loadTypeExceptions(filePath string) (map[string]string, error) {
+	m := map[string]string{}
+	err := loadJSONFile("type exception", filePath, &m)
+	return m, err
 }
 
-func loadTypeExceptions(filePath string) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		log.Fatalf("Failed to read type exception file %s: %v", filePath, err)
-	}
+func loadSkipFields(filePath string) (map[string]bool, error) {
+	m := map[string]bool{}
+	err := loadJSONFile("skip fields", filePath, &m)
+	return m, err
+}
 
-	err = json.Unmarshal(data, &TypeExceptions)
-	if err != nil {
-		log.Fatalf("Error unmarshalling JSON from type exception file %s: %v", filePath, err)
-	}
+func loadFieldComments(filePath string) (map[string]string, error) {
+	m := map[string]string{}
+	err := loadJSONFile("field comments", filePath, &m)
+	return m, err
 }
 
-func loadSkipFields(filePath string) {
+// loadJSONFile 读取JSON文件并解析到v，解析失败时错误附带文件名和出错的行列号
+func loadJSONFile(kind, filePath string, v any) error {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		log.Fatalf("Failed to read skip fields file %s: %v", filePath, err)
+		return fmt.Errorf("Failed to read %s file %s: %w", kind, filePath, err)
 	}
-
-	err = json.Unmarshal(data, &SkipFields)
+	err = json.Unmarshal(data, v)
 	if err != nil {
-		log.Fatalf("Error unmarshalling JSON from skip fields file %s: %v", filePath, err)
+		return fmt.Errorf("Error unmarshalling JSON from %s file %s: %w", kind, jsonErrorPos(filePath, data, err), err)
 	}
+	return nil
 }
 
-func loadFieldComments(filePath string) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		log.Fatalf("Failed to read field comments file %s: %v", filePath, err)
-	}
-
-	err = json.Unmarshal(data, &FieldComments)
-	if err != nil {
-		log.Fatalf("Error unmarshalling JSON from field comments file %s: %v", filePath, err)
+// jsonErrorPos 将JSON解析错误的字节偏移转换为file:line:col格式的位置，无法定位时只返回文件名
+func jsonErrorPos(filePath string, data []byte, err error) string {
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return filePath
+	}
+	offset = min(offset, int64(len(data)))
+
+	line, col := 1, 1
+	for _, c := range data[:offset] {
+		if c == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
 	}
+	return fmt.Sprintf("%s:%d:%d", filePath, line, col)
 }
 
 // GeoPoint Elasticsearch的地理坐标
@@ -434,25 +569,8 @@ type StructTplData struct {
 	StructDefinitions string // 模型结构体定义，所有属性的渲染都已在go代码实现
 }
 
-// StructTplWithWrapper .
-const StructTplWithWrapper = `// Code generated by es2go. DO NOT EDIT.
-package {{.PackageName}}
-
-import "time"
-
-type {{.InitClassName}} struct {
-	{{.StructName}}
-}
-
-{{.StructDefinitions}}
-`
-
-// StructTplWithoutWrapper .
-const StructTplWithoutWrapper = `// Code generated by es2go. DO NOT EDIT.
-
-package {{.PackageName}}
-
-import "time"
+// StructTplWithWrapper 带封装类型的结构体代码模板
+var StructTplWithWrapper = builtinTemplate("struct_wrapper.tmpl")
 
-{{.StructDefinitions}}
-`
+// StructTplWithoutWrapper 结构体代码模板
+var StructTplWithoutWrapper = builtinTemplate("struct.tmpl")
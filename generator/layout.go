@@ -0,0 +1,203 @@
+package generator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// 输出文件的布局：输出目录、文件命名模式、模型与查询函数的子目录以及大文件的分片
+
+// 布局相关的默认值
+const (
+	DefaultFilePattern = "{model}{suffix}.go" // 默认的文件命名模式，如books_detail_range.go
+	ModelSubDir        = "model"              // 拆分目录时模型结构体所在的子目录
+	QuerySubDir        = "query"              // 拆分目录时查询函数所在的子目录，同时作为包名
+)
+
+// Layout 输出文件的布局，零值表示全部文件输出到模型文件所在的目录，与原有的命名方式一致
+type Layout struct {
+	OutDir          string // 输出目录，为空时使用模型文件所在的目录
+	FilePattern     string // 文件命名模式，{model}为模型文件名(不含.go)，{family}为生成器名称，{suffix}为生成器的文件后缀
+	SplitPackages   bool   // 模型结构体输出到model/子目录，通用辅助函数和查询函数输出到query/子目录
+	ModelImport     string // model/子目录的导入路径，为空时根据go.mod推断
+	MaxFuncsPerFile int    // 单个查询文件最多的函数数量，超出时拆分为编号的分片，0表示不拆分
+	Doc             bool   // 在每个输出目录生成列出文件内容的doc.go
+}
+
+// Validate 检查文件命名模式，避免不同的生成器输出到同一个文件
+func (l *Layout) Validate() error {
+	pattern := l.pattern()
+	if !strings.HasSuffix(pattern, ".go") {
+		return fmt.Errorf("file pattern %q must end with .go", pattern)
+	}
+	if strings.ContainsAny(pattern, `/\`) {
+		return fmt.Errorf("file pattern %q must not contain path separators, use the output directory instead", pattern)
+	}
+	if !strings.Contains(pattern, "{suffix}") && !strings.Contains(pattern, "{family}") {
+		return fmt.Errorf("file pattern %q must contain {suffix} or {family}", pattern)
+	}
+	if l.MaxFuncsPerFile < 0 {
+		return fmt.Errorf("max funcs per file must not be negative: %d", l.MaxFuncsPerFile)
+	}
+	return nil
+}
+
+// pattern 文件命名模式，未设置时使用默认模式
+func (l *Layout) pattern() string {
+	if l == nil || l.FilePattern == "" {
+		return DefaultFilePattern
+	}
+	return l.FilePattern
+}
+
+// baseDir 输出的根目录
+func (l *Layout) baseDir(outputPath string) string {
+	if l != nil && l.OutDir != "" {
+		return l.OutDir
+	}
+	return filepath.Dir(outputPath)
+}
+
+// ModelDir 模型结构体文件所在的目录
+func (l *Layout) ModelDir(outputPath string) string {
+	if l != nil && l.SplitPackages {
+		return filepath.Join(l.baseDir(outputPath), ModelSubDir)
+	}
+	return l.baseDir(outputPath)
+}
+
+// QueryDir 通用辅助函数和查询函数文件所在的目录
+func (l *Layout) QueryDir(outputPath string) string {
+	if l != nil && l.SplitPackages {
+		return filepath.Join(l.baseDir(outputPath), QuerySubDir)
+	}
+	return l.baseDir(outputPath)
+}
+
+// FileName 按命名模式生成文件名，shard大于0时追加分片编号。
+// 模式中含有{suffix}时编号追加在后缀之后，否则追加在.go之前
+func (l *Layout) FileName(outputPath, family, suffix string, shard int) string {
+	pattern := l.pattern()
+	shardSuffix := ""
+	if shard > 0 {
+		shardSuffix = "_" + strconv.Itoa(shard)
+	}
+	if strings.Contains(pattern, "{suffix}") {
+		suffix += shardSuffix
+	} else {
+		pattern = strings.TrimSuffix(pattern, ".go") + shardSuffix + ".go"
+	}
+
+	model := strings.TrimSuffix(filepath.Base(outputPath), ".go")
+	r := strings.NewReplacer("{model}", model, "{family}", family, "{suffix}", suffix)
+	return r.Replace(pattern)
+}
+
+// ModelPath 模型结构体文件的路径
+func (l *Layout) ModelPath(outputPath string) string {
+	return filepath.Join(l.ModelDir(outputPath), l.FileName(outputPath, "model", "", 0))
+}
+
+// QueryPath 通用辅助函数或查询函数文件的路径
+func (l *Layout) QueryPath(outputPath, family, suffix string, shard int) string {
+	return filepath.Join(l.QueryDir(outputPath), l.FileName(outputPath, family, suffix, shard))
+}
+
+// queryPackage 查询函数文件的包名，拆分目录时使用query
+func (l *Layout) queryPackage(packageName string) string {
+	if l != nil && l.SplitPackages {
+		return QuerySubDir
+	}
+	return packageName
+}
+
+// modelType 查询函数文件中引用模型结构体的类型名称，拆分目录时需要包名限定
+func (l *Layout) modelType(packageName, structName string) string {
+	if l != nil && l.SplitPackages {
+		return packageName + "." + structName
+	}
+	return structName
+}
+
+// shards 按单个文件最多的函数数量拆分函数列表，不需要拆分时只有一个分片
+func (l *Layout) shards(funcDatas []*FuncTplData) [][]*FuncTplData {
+	if l == nil || l.MaxFuncsPerFile <= 0 || len(funcDatas) <= l.MaxFuncsPerFile {
+		return [][]*FuncTplData{funcDatas}
+	}
+	shards := [][]*FuncTplData{}
+	for start := 0; start < len(funcDatas); start += l.MaxFuncsPerFile {
+		end := min(start+l.MaxFuncsPerFile, len(funcDatas))
+		shards = append(shards, funcDatas[start:end])
+	}
+	return shards
+}
+
+// resolveModelImport 拆分目录且未指定导入路径时，根据go.mod推断model/子目录的导入路径
+func (l *Layout) resolveModelImport(outputPath string) error {
+	if !l.SplitPackages || l.ModelImport != "" {
+		return nil
+	}
+	dir, err := filepath.Abs(l.ModelDir(outputPath))
+	if err != nil {
+		return err
+	}
+	importPath, err := inferImportPath(dir)
+	if err != nil {
+		return fmt.Errorf("Failed to infer import path of %s, specify it explicitly: %v", dir, err)
+	}
+	l.ModelImport = importPath
+	return nil
+}
+
+// inferImportPath 向上查找go.mod，由模块路径和相对目录推断目录的导入路径
+func inferImportPath(dir string) (string, error) {
+	for root := dir; ; root = filepath.Dir(root) {
+		modulePath, err := readModulePath(filepath.Join(root, "go.mod"))
+		if err != nil {
+			return "", err
+		}
+		if modulePath != "" {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", err
+			}
+			if rel == "." {
+				return modulePath, nil
+			}
+			return modulePath + "/" + filepath.ToSlash(rel), nil
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("go.mod not found")
+		}
+	}
+}
+
+// readModulePath 读取go.mod中的模块路径，文件不存在时返回空字符串
+func readModulePath(path string) (string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("Failed to read file %s: %v", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, "module"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("Failed to read file %s: %v", path, err)
+	}
+	return "", fmt.Errorf("module directive not found in %s", path)
+}
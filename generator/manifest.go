@@ -0,0 +1,297 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+)
+
+// 增量生成：在每个输出目录的清单中记录各生成文件的输入摘要(mapping、配置、模板和es2go版本)和内容摘要。
+// 输入摘要未变化且文件未被修改时跳过渲染，内容未变化的文件不重写，同一模型不再生成的文件(如关闭的查询类型、减少的分片)被删除
+
+// Version es2go的版本，参与输入摘要的计算
+const Version = "0.1.0"
+
+// ManifestFileName 输出目录中清单文件的名称
+const ManifestFileName = ".es2go-manifest.json"
+
+// Manifest 输出目录的清单，记录目录中各模型的生成文件
+type Manifest struct {
+	Version string                    `json:"version"` // 生成清单的es2go版本
+	Files   map[string]*ManifestEntry `json:"files"`   // 文件名 -> 文件信息
+}
+
+// ManifestEntry 清单中一个生成文件的信息
+type ManifestEntry struct {
+	Model     string `json:"model"`     // 模型结构体名称
+	Generator string `json:"generator"` // 生成该文件的生成器名称
+	Input     string `json:"input"`     // 输入摘要
+	Output    string `json:"output"`    // 文件内容摘要
+}
+
+// WriteStats 增量写入的结果
+type WriteStats struct {
+	Written   []string // 新建或内容变化的文件
+	Unchanged []string // 内容未变化而跳过的文件
+	Deleted   []string // 删除的文件
+}
+
+// BuildVersion es2go的版本，源码构建时附带vcs修订号
+func BuildVersion() string {
+	version := Version
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return version
+	}
+	for _, s := range info.Settings {
+		switch {
+		case s.Key == "vcs.revision":
+			version += "+" + s.Value
+		case s.Key == "vcs.modified" && s.Value == "true":
+			version += "-dirty"
+		}
+	}
+	return version
+}
+
+// InputHash 计算模型的输入摘要，包括解析mapping和配置得到的模型信息、生成规格、布局、
+// 内置模板、模板目录中的文件、已注册生成器的模板以及es2go的版本号。
+// 版本号不含vcs修订号，es2go的提交或未提交的修改不会使清单失效，模板的变化由模板内容反映
+func InputHash(esInfo *EsModelInfo) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "es2go %s\n", Version)
+
+	data, err := json.Marshal(esInfo)
+	if err != nil {
+		return "", fmt.Errorf("Failed to hash model info of %s: %v", esInfo.StructName, err)
+	}
+	h.Write(data)
+
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		fmt.Fprintf(h, "\nbuiltin %s\n%s", e.Name(), builtinTemplate(e.Name()))
+	}
+
+	if esInfo.TmplDir != "" {
+		entries, err := os.ReadDir(esInfo.TmplDir)
+		if err != nil {
+			return "", fmt.Errorf("Failed to read template directory %s: %v", esInfo.TmplDir, err)
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			src, err := os.ReadFile(filepath.Join(esInfo.TmplDir, e.Name()))
+			if err != nil {
+				return "", fmt.Errorf("Failed to read template file %s: %v", filepath.Join(esInfo.TmplDir, e.Name()), err)
+			}
+			fmt.Fprintf(h, "\ntmpl %s\n%s", e.Name(), src)
+		}
+	}
+
+	for _, g := range Generators() {
+		fmt.Fprintf(h, "\ngenerator %s %s\n%s", g.Name(), g.FileSuffix(), g.Template())
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// contentHash 文件内容的摘要
+func contentHash(src []byte) string {
+	sum := sha256.Sum256(src)
+	return hex.EncodeToString(sum[:])
+}
+
+// LoadManifest 加载输出目录的清单，清单不存在时返回空的清单
+func LoadManifest(dir string) (*Manifest, error) {
+	m := &Manifest{Version: Version, Files: map[string]*ManifestEntry{}}
+	path := filepath.Join(dir, ManifestFileName)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read manifest %s: %v", path, err)
+	}
+	err = json.Unmarshal(data, m)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse manifest %s: %w", jsonErrorPos(path, data, err), err)
+	}
+	if m.Files == nil {
+		m.Files = map[string]*ManifestEntry{}
+	}
+	return m, nil
+}
+
+// Save 写入输出目录的清单，清单为空时删除清单文件
+func (m *Manifest) Save(dir string) error {
+	path := filepath.Join(dir, ManifestFileName)
+	if len(m.Files) == 0 {
+		err := os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("Failed to remove manifest %s: %v", path, err)
+		}
+		return nil
+	}
+
+	m.Version = Version
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return (&OutputFile{Path: path, Generator: "manifest", Source: append(data, '\n')}).Write()
+}
+
+// modelFiles 清单中属于指定模型的文件名，按名称排序
+func (m *Manifest) modelFiles(model string) []string {
+	names := []string{}
+	for name, entry := range m.Files {
+		if entry.Model == model {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// manifestDirs 模型的输出目录，未拆分目录时只有一个
+func manifestDirs(outputPath string, esInfo *EsModelInfo) []string {
+	dirs := []string{filepath.Clean(esInfo.Layout.ModelDir(outputPath))}
+	if queryDir := filepath.Clean(esInfo.Layout.QueryDir(outputPath)); queryDir != dirs[0] {
+		dirs = append(dirs, queryDir)
+	}
+	return dirs
+}
+
+// orphanedFiles 清单中记录为该模型生成、但本次渲染不再生成的文件，增量写入时将被删除
+func orphanedFiles(outputPath string, esInfo *EsModelInfo, files []*OutputFile) ([]string, error) {
+	current := map[string]bool{}
+	for _, f := range files {
+		current[filepath.Clean(f.Path)] = true
+	}
+	orphans := []string{}
+	for _, dir := range manifestDirs(outputPath, esInfo) {
+		m, err := LoadManifest(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range m.modelFiles(esInfo.StructName) {
+			if path := filepath.Join(dir, name); !current[path] {
+				orphans = append(orphans, path)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// LoadUpToDate 清单中该模型的全部文件的输入摘要都等于hash、且磁盘上的文件未被修改时，返回磁盘上的文件，否则返回nil。
+// 模型和通用辅助函数文件必须在清单中，避免把只生成了部分文件的清单视为最新
+func LoadUpToDate(outputPath string, esInfo *EsModelInfo, hash string) ([]*OutputFile, error) {
+	files := []*OutputFile{}
+	generators := map[string]bool{}
+	for _, dir := range manifestDirs(outputPath, esInfo) {
+		m, err := LoadManifest(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range m.modelFiles(esInfo.StructName) {
+			entry := m.Files[name]
+			if entry.Input != hash {
+				return nil, nil
+			}
+			path := filepath.Join(dir, name)
+			src, err := os.ReadFile(path)
+			if err != nil || contentHash(src) != entry.Output {
+				return nil, nil
+			}
+			files = append(files, &OutputFile{Path: path, Generator: entry.Generator, Source: src})
+			generators[entry.Generator] = true
+		}
+	}
+	if !generators["model"] || !generators["common"] {
+		return nil, nil
+	}
+	return files, nil
+}
+
+// WriteIncremental 写入模型渲染的文件并更新输出目录的清单：内容未变化的文件不重写，
+// prune为true时删除清单中该模型不再生成的文件，被手工修改过的文件保留并返回错误。
+// 部分生成器渲染失败时应传入false，以免删除这些生成器上次生成的文件。doc.go由目录中的文件汇总，不记录在清单中
+func WriteIncremental(outputPath string, esInfo *EsModelInfo, hash string, files []*OutputFile, prune bool) (*WriteStats, error) {
+	stats := &WriteStats{}
+	manifests := map[string]*Manifest{}
+	for _, dir := range manifestDirs(outputPath, esInfo) {
+		m, err := LoadManifest(dir)
+		if err != nil {
+			return nil, err
+		}
+		manifests[dir] = m
+	}
+
+	current := map[string]bool{}
+	for _, f := range files {
+		if f.Generator == "doc" {
+			continue
+		}
+		dir, name := filepath.Clean(filepath.Dir(f.Path)), filepath.Base(f.Path)
+		current[filepath.Join(dir, name)] = true
+
+		old, err := os.ReadFile(f.Path)
+		if err == nil && bytes.Equal(old, f.Source) {
+			stats.Unchanged = append(stats.Unchanged, f.Path)
+		} else {
+			err = f.Write()
+			if err != nil {
+				return stats, err
+			}
+			stats.Written = append(stats.Written, f.Path)
+		}
+
+		m, ok := manifests[dir]
+		if !ok {
+			continue
+		}
+		m.Files[name] = &ManifestEntry{Model: esInfo.StructName, Generator: f.Generator, Input: hash, Output: contentHash(f.Source)}
+	}
+
+	errs := []error{}
+	for dir, m := range manifests {
+		for _, name := range m.modelFiles(esInfo.StructName) {
+			path := filepath.Join(dir, name)
+			if current[path] || !prune {
+				continue
+			}
+			// 删除前确认文件仍是上次生成的内容
+			src, err := os.ReadFile(path)
+			if err == nil && contentHash(src) != m.Files[name].Output {
+				errs = append(errs, fmt.Errorf("%s is no longer generated but was modified by hand, remove it manually", path))
+				continue
+			}
+			if err == nil {
+				err = os.Remove(path)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("Failed to remove orphaned file %s: %v", path, err))
+					continue
+				}
+				stats.Deleted = append(stats.Deleted, path)
+			}
+			delete(m.Files, name)
+		}
+
+		err := m.Save(dir)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return stats, errors.Join(errs...)
+}
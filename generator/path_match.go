@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"path"
+	"strings"
+)
+
+// 按字段路径查找异常字段、异常类型、忽略字段和字段注释的配置。
+// 配置的键可以是：
+//   - 完整的点分路径，如menu_items.items.price，只匹配该字段
+//   - 通配符模式，如*.price、menu_items.*.price，*匹配任意字符(包括.)，语法同path.Match
+//   - 不含.的字段名，如price，匹配任意层级的同名字段，兼容原有的配置
+//
+// 同一字段匹配多个键时，完整路径优先，其次是非通配字符最多的通配符模式，最后是字段名
+
+// 匹配的优先级
+const (
+	matchNone    = iota
+	matchName    // 字段名
+	matchGlob    // 通配符模式
+	matchExactly // 完整路径
+)
+
+// lookupField 按字段路径查找配置，fieldPath为完整的点分路径，name为字段名
+func lookupField[V any](m map[string]V, fieldPath, name string) (V, bool) {
+	var best V
+	if len(m) == 0 {
+		return best, false
+	}
+	if v, ok := m[fieldPath]; ok {
+		return v, true
+	}
+
+	bestRank, bestScore, bestKey := matchNone, -1, ""
+	for key, v := range m {
+		rank, score := matchKey(key, fieldPath, name)
+		if rank == matchNone {
+			continue
+		}
+		// 优先级和非通配字符数量相同时取字典序较小的键，保证结果确定
+		if rank > bestRank || (rank == bestRank && (score > bestScore || (score == bestScore && key < bestKey))) {
+			best, bestRank, bestScore, bestKey = v, rank, score, key
+		}
+	}
+	return best, bestRank != matchNone
+}
+
+// matchKey 判断配置的键是否匹配字段，返回匹配的优先级和键中非通配字符的数量
+func matchKey(key, fieldPath, name string) (int, int) {
+	if strings.ContainsAny(key, "*?[") {
+		ok, err := path.Match(key, fieldPath)
+		if err != nil || !ok {
+			return matchNone, 0
+		}
+		literal := len(key) - strings.Count(key, "*") - strings.Count(key, "?")
+		return matchGlob, literal
+	}
+	if key == fieldPath {
+		return matchExactly, len(key)
+	}
+	if !strings.Contains(key, ".") && key == name {
+		return matchName, len(key)
+	}
+	return matchNone, 0
+}
+
+// joinFieldPath 拼接字段的点分路径
+func joinFieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,86 @@
+package generator
+
+import "testing"
+
+func TestLookupField(t *testing.T) {
+	const fieldPath, name = "menu_items.items.price", "price"
+	tests := []struct {
+		name   string
+		m      map[string]string
+		want   string
+		wantOK bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "full path",
+			m:      map[string]string{"menu_items.items.price": "path"},
+			want:   "path",
+			wantOK: true,
+		},
+		{
+			name:   "leading glob",
+			m:      map[string]string{"*.price": "glob"},
+			want:   "glob",
+			wantOK: true,
+		},
+		{
+			name:   "inner glob",
+			m:      map[string]string{"menu_items.*.price": "glob"},
+			want:   "glob",
+			wantOK: true,
+		},
+		{
+			name:   "field name",
+			m:      map[string]string{"price": "name"},
+			want:   "name",
+			wantOK: true,
+		},
+		{
+			name: "no match",
+			m:    map[string]string{"menu_items.price": "path", "*.cost": "glob", "cost": "name", "items.price": "suffix"},
+		},
+		{
+			name: "full path before globs and name",
+			m: map[string]string{
+				"menu_items.items.price": "path",
+				"menu_items.*.price":     "inner",
+				"*.price":                "leading",
+				"price":                  "name",
+			},
+			want:   "path",
+			wantOK: true,
+		},
+		{
+			name:   "more specific glob first",
+			m:      map[string]string{"menu_items.*.price": "inner", "*.price": "leading", "price": "name"},
+			want:   "inner",
+			wantOK: true,
+		},
+		{
+			name:   "glob before name",
+			m:      map[string]string{"*.price": "leading", "price": "name"},
+			want:   "leading",
+			wantOK: true,
+		},
+		{
+			// 非通配字符数量相同(11个)时取字典序较小的键
+			name:   "ambiguous globs of equal specificity",
+			m:      map[string]string{"menu_*.price": "prefix", "*items.price": "suffix"},
+			want:   "suffix",
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// map的遍历顺序随机，多次查找以确认结果确定
+			for i := 0; i < 20; i++ {
+				got, ok := lookupField(tt.m, fieldPath, name)
+				if got != tt.want || ok != tt.wantOK {
+					t.Fatalf("lookupField(%v) = %q, %v, want %q, %v", tt.m, got, ok, tt.want, tt.wantOK)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 统计各查询类型将要生成的函数数量，不生成具体的字段组合
+
+// 超出预算时的处理方式
+const (
+	BudgetAbort  = "abort"  // 终止生成
+	BudgetSample = "sample" // 按比例分配各查询类型的函数数量
+)
+
+// PlanItem 单个查询类型的函数数量估算
+type PlanItem struct {
+	Family string   // 查询类型
+	Funcs  *big.Int // 按组合公式计算的函数数量
+	Limit  int      // 规格限制的最大函数数量，0表示不限制
+}
+
+// Effective 获取实际生成的函数数量上限，限制按每个字段组合生成的函数数量向下取整
+func (p *PlanItem) Effective() *big.Int {
+	limit := p.Limit - p.Limit%familyUnit(p.Family)
+	if p.Limit > 0 && p.Funcs.Cmp(big.NewInt(int64(limit))) > 0 {
+		return big.NewInt(int64(limit))
+	}
+	return p.Funcs
+}
+
+// familyUnit 查询类型每个字段组合生成的函数数量，检索函数同时生成高亮版本
+func familyUnit(family string) int {
+	switch family {
+	case FamilyMatch, FamilyFilter:
+		return 2
+	}
+	return 1
+}
+
+// PlanFuncs 估算各查询类型将要生成的函数数量，未考虑字段对的约束，结果为上限
+func PlanFuncs(esInfo *EsModelInfo) []*PlanItem {
+	spec := esInfo.Spec
+	grpFileds := GroupFieldsByType(esInfo.Fields)
+
+	// 各角色的字段
+	textFields := spec.RoleFields(RoleMatch, grpFileds[TypeText])
+	termFields := spec.RoleFields(RoleFilter, append(slices.Clone(grpFileds[TypeKeyword]), grpFileds[TypeNumber]...))
+	rangeFields := spec.RoleFields(RoleRange, grpFileds[TypeNumber])
+	filterFields := aggFilterFields(spec, grpFileds)
+	distinctFields := spec.RoleFields(RoleGroup, append(slices.Clone(grpFileds[TypeKeyword]), grpFileds[TypeTextKeyword]...))
+	numberFields := spec.RoleFields(RoleMetric, grpFileds[TypeNumber])
+	dimFields := spec.RoleFields(RoleGroup, append(append(slices.Clone(grpFileds[TypeKeyword]), grpFileds[TypeTextKeyword]...), grpFileds[TypeDate]...))
+	dateFields := spec.RoleFields(RoleGroup, grpFileds[TypeDate])
+	nMetrics := big.NewInt(int64(len(aggMetrics(spec, grpFileds))))
+
+	counts := map[string]*big.Int{}
+
+	// 检索函数同时生成高亮版本
+	n := utils.CombinationCount(len(textFields), spec.CombineLimit(FamilyMatch, MaxCombine))
+	counts[FamilyMatch] = n.Mul(n, big.NewInt(int64(familyUnit(FamilyMatch))))
+
+	n = utils.CombinationCount(len(termFields), spec.CombineLimit(FamilyFilter, MaxCombine)-1)
+	n.Mul(n, big.NewInt(int64(len(textFields))))
+	counts[FamilyFilter] = n.Mul(n, big.NewInt(int64(familyUnit(FamilyFilter))))
+
+	counts[FamilyRange] = rangeCount(len(rangeFields), spec.CombineLimit(FamilyRange, MaxRangeCombine))
+	counts[FamilyTerm] = utils.CombinationCount(len(termFields), spec.CombineLimit(FamilyTerm, MaxCombine))
+
+	counts[FamilyDistinct] = aggFilterCount(distinctFields, filterFields, spec.CombineLimit(FamilyDistinct, MaxCombine))
+	counts[FamilyCardinality] = aggFilterCount(distinctFields, filterFields, spec.CombineLimit(FamilyCardinality, MaxCombine))
+	n = aggFilterCount(numberFields, filterFields, spec.CombineLimit(FamilyDistribution, MaxCombine))
+	counts[FamilyDistribution] = n.Mul(n, big.NewInt(int64(len(distList))))
+
+	n = utils.CombinationCount(len(dimFields), groupCombineLimit(spec))
+	counts[FamilyGroup] = n.Mul(n, nMetrics)
+	n = big.NewInt(int64(len(dateFields)))
+	counts[FamilyCompare] = n.Mul(n, nMetrics)
+
+	items := []*PlanItem{}
+	for _, family := range families {
+		if !spec.Enabled(family) {
+			continue
+		}
+		items = append(items, &PlanItem{Family: family, Funcs: counts[family], Limit: spec.FuncLimit(family)})
+	}
+	return items
+}
+
+// families 按生成顺序排列的全部查询类型
+var families = []string{
+	FamilyMatch, FamilyFilter, FamilyRange, FamilyTerm,
+	FamilyDistinct, FamilyCardinality, FamilyDistribution, FamilyGroup, FamilyCompare,
+}
+
+// rangeCount 计算范围查询的函数数量，每个字段有len(optList)种比较方式
+func rangeCount(n, maxCount int) *big.Int {
+	total := big.NewInt(0)
+	opts := big.NewInt(int64(len(optList)))
+	for k := 1; k <= maxCount && k <= n; k++ {
+		c := new(big.Int).Binomial(int64(n), int64(k))
+		total.Add(total, c.Mul(c, new(big.Int).Exp(opts, big.NewInt(int64(k)), nil)))
+	}
+	return total
+}
+
+// aggFilterCount 计算聚合函数的数量，每个目标字段与除自身外的过滤字段组合，另加无过滤条件的版本
+func aggFilterCount(targets, filters []*FieldInfo, maxCombine int) *big.Int {
+	total := big.NewInt(0)
+	for _, f := range targets {
+		others := len(utils.FilterOut(filters, []*FieldInfo{f}))
+		total.Add(total, utils.CombinationCount(others, maxCombine-1))
+		total.Add(total, big.NewInt(1))
+	}
+	return total
+}
+
+// PlanTotal 汇总实际生成的函数数量
+func PlanTotal(items []*PlanItem) *big.Int {
+	total := big.NewInt(0)
+	for _, item := range items {
+		total.Add(total, item.Effective())
+	}
+	return total
+}
+
+// PrintPlan 以表格形式输出各查询类型的函数数量
+func PrintPlan(w io.Writer, esInfo *EsModelInfo, items []*PlanItem) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", esInfo.StructName, "COMBINATIONS", "LIMIT", "FUNCS")
+	for _, item := range items {
+		limit := "-"
+		if item.Limit > 0 {
+			limit = fmt.Sprint(item.Limit)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", item.Family, item.Funcs, limit, item.Effective())
+	}
+	fmt.Fprintf(tw, "%s\t\t\t%s\t\n", "TOTAL", PlanTotal(items))
+	tw.Flush()
+}
+
+// ApplyBudget 检查函数总数是否超出预算，abort模式返回错误，sample模式按各查询类型的数量比例限制生成的函数数量
+func ApplyBudget(esInfo *EsModelInfo, items []*PlanItem, budget int, mode string) error {
+	total := PlanTotal(items)
+	if budget <= 0 || total.Cmp(big.NewInt(int64(budget))) <= 0 {
+		return nil
+	}
+
+	switch mode {
+	case BudgetAbort, "":
+		return fmt.Errorf("%s would generate %s functions, exceeding the budget of %d", esInfo.StructName, total, budget)
+	case BudgetSample:
+	default:
+		return fmt.Errorf("Unknown budget mode %s", mode)
+	}
+
+	if esInfo.Spec == nil {
+		esInfo.Spec = &GenSpec{}
+	}
+	if esInfo.Spec.MaxFuncs == nil {
+		esInfo.Spec.MaxFuncs = map[string]int{}
+	}
+
+	// 每个查询类型先保留一个字段组合，预算不足时返回错误
+	active := []*PlanItem{}
+	reserved := 0
+	for _, item := range items {
+		if item.Effective().Sign() > 0 {
+			active = append(active, item)
+			reserved += familyUnit(item.Family)
+		}
+	}
+	if budget < reserved {
+		return fmt.Errorf("budget of %d is too small for %d query families of %s, at least %d functions are needed", budget, len(active), esInfo.StructName, reserved)
+	}
+
+	// 剩余的预算按各查询类型剩余的函数数量比例分配，按每个字段组合生成的函数数量取整，
+	// 取整后余下的预算按最大余数依次分配，分配的总数不超过预算
+	rest := big.NewInt(int64(budget - reserved))
+	restTotal := big.NewInt(0)
+	for _, item := range active {
+		restTotal.Add(restTotal, new(big.Int).Sub(item.Effective(), big.NewInt(int64(familyUnit(item.Family)))))
+	}
+	limits := make([]int, len(active))
+	remainders := make([]*big.Int, len(active))
+	left := budget - reserved
+	for idx, item := range active {
+		unit := int64(familyUnit(item.Family))
+		share := new(big.Int).Sub(item.Effective(), big.NewInt(unit))
+		share.Mul(share, rest)
+		quota, rem := new(big.Int).QuoRem(share, restTotal, new(big.Int))
+		// 组合数量可能超出int64，全程使用big.Int计算，按单位取整时舍去的部分计入余数
+		dropped := new(big.Int).Mod(quota, big.NewInt(unit))
+		rem.Add(rem, new(big.Int).Mul(dropped, restTotal))
+		quota.Sub(quota, dropped)
+		// 分配的数量不超过剩余的预算，可以转换为int
+		extra := int(quota.Int64())
+		limits[idx] = int(unit) + extra
+		remainders[idx] = rem
+		left -= extra
+	}
+	order := make([]int, len(active))
+	for idx := range order {
+		order[idx] = idx
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return remainders[b].Cmp(remainders[a]) })
+	for _, idx := range order {
+		unit := familyUnit(active[idx].Family)
+		if unit <= left && active[idx].Effective().Cmp(big.NewInt(int64(limits[idx]+unit))) >= 0 {
+			limits[idx] += unit
+			left -= unit
+		}
+	}
+
+	for idx, item := range active {
+		if item.Limit == 0 || limits[idx] < item.Limit {
+			esInfo.Spec.MaxFuncs[item.Family] = limits[idx]
+			item.Limit = limits[idx]
+		}
+	}
+	return nil
+}
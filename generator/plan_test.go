@@ -0,0 +1,167 @@
+package generator
+
+import (
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// exampleMappings 示例中的mapping文件
+var exampleMappings = []string{"books.json", "products-mapping.json", "cafe-mapping.json"}
+
+// loadExample 解析示例mapping，不写入文件
+func loadExample(t *testing.T, name string, opts *GenOptions) *EsModelInfo {
+	t.Helper()
+	if opts == nil {
+		opts = &GenOptions{}
+	}
+	opts.SkipWrite = true
+	structName := strings.TrimSuffix(strings.TrimSuffix(name, ".json"), "-mapping")
+	esInfo, err := GenEsModel(filepath.Join("..", "example", "elasticsearch", name), filepath.Join(t.TempDir(), structName+".go"), "model", "M"+structName, opts)
+	if err != nil {
+		t.Fatalf("GenEsModel(%s): %v", name, err)
+	}
+	return esInfo
+}
+
+// generatedFuncs 各查询类型实际生成的函数数量，检索函数的高亮版本单独计数
+func generatedFuncs(esInfo *EsModelInfo) map[string]int64 {
+	counts := map[string]int64{}
+	for _, g := range Generators() {
+		if !esInfo.Spec.Enabled(g.Name()) {
+			continue
+		}
+		for _, fd := range g.Prepare(esInfo) {
+			counts[g.Name()]++
+			if fd.Highlight != "" {
+				counts[g.Name()]++
+			}
+		}
+	}
+	return counts
+}
+
+// checkPlan 检查估算的函数数量与实际生成的数量一致
+func checkPlan(t *testing.T, esInfo *EsModelInfo, items []*PlanItem) int64 {
+	t.Helper()
+	counts := generatedFuncs(esInfo)
+	total := int64(0)
+	for _, item := range items {
+		if got, want := counts[item.Family], item.Effective(); big.NewInt(got).Cmp(want) != 0 {
+			t.Errorf("%s %s: generated %d functions, plan reports %s", esInfo.StructName, item.Family, got, want)
+		}
+		total += counts[item.Family]
+	}
+	if plan := PlanTotal(items); big.NewInt(total).Cmp(plan) != 0 {
+		t.Errorf("%s: generated %d functions in total, plan reports %s", esInfo.StructName, total, plan)
+	}
+	return total
+}
+
+func TestPlanFuncsMatchesGenerated(t *testing.T) {
+	for _, name := range exampleMappings {
+		esInfo := loadExample(t, name, nil)
+		checkPlan(t, esInfo, PlanFuncs(esInfo))
+	}
+}
+
+func TestApplyBudgetSample(t *testing.T) {
+	for _, name := range exampleMappings {
+		for _, budget := range []int{11, 12, 25, 100, 333} {
+			esInfo := loadExample(t, name, nil)
+			items := PlanFuncs(esInfo)
+			full := PlanTotal(items)
+			err := ApplyBudget(esInfo, items, budget, BudgetSample)
+			if err != nil {
+				// 预算不足以为每个查询类型保留一个字段组合
+				reserved := 0
+				for _, item := range items {
+					if item.Funcs.Sign() > 0 {
+						reserved += familyUnit(item.Family)
+					}
+				}
+				if budget >= reserved {
+					t.Errorf("%s budget %d: unexpected error: %v", name, budget, err)
+				}
+				continue
+			}
+
+			total := checkPlan(t, esInfo, items)
+			if total > int64(budget) {
+				t.Errorf("%s budget %d: generated %d functions", name, budget, total)
+			}
+			if full.Cmp(big.NewInt(int64(budget))) > 0 && total < int64(budget)-2 {
+				t.Errorf("%s budget %d: only %d functions generated, the budget is not used", name, budget, total)
+			}
+			for _, item := range items {
+				if item.Funcs.Sign() > 0 && item.Effective().Sign() == 0 {
+					t.Errorf("%s budget %d: family %s has no functions", name, budget, item.Family)
+				}
+			}
+		}
+	}
+}
+
+func TestApplyBudgetTooSmall(t *testing.T) {
+	esInfo := loadExample(t, "books.json", nil)
+	err := ApplyBudget(esInfo, PlanFuncs(esInfo), 10, BudgetSample)
+	if err == nil || !strings.Contains(err.Error(), "too small") {
+		t.Fatalf("ApplyBudget with budget 10 = %v, want budget too small error", err)
+	}
+}
+
+func TestApplyBudgetAbort(t *testing.T) {
+	esInfo := loadExample(t, "books.json", nil)
+	err := ApplyBudget(esInfo, PlanFuncs(esInfo), 100, BudgetAbort)
+	if err == nil {
+		t.Fatal("ApplyBudget in abort mode returned nil for an exceeded budget")
+	}
+	err = ApplyBudget(esInfo, PlanFuncs(esInfo), 1000, BudgetAbort)
+	if err != nil {
+		t.Fatalf("ApplyBudget within the budget: %v", err)
+	}
+}
+
+func TestApplyBudgetHugeCounts(t *testing.T) {
+	// 组合数量超出int64：剩余的组合数量为3*2^62，检索函数按比例分到4/3个，取整舍去的部分计入余数后，
+	// 余数(4/3份)大于精确查询的余数(2/3份)，应优先分到余下的预算
+	items := []*PlanItem{
+		{Family: FamilyMatch, Funcs: new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 62), big.NewInt(2))},
+		{Family: FamilyTerm, Funcs: new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 63), big.NewInt(1))},
+	}
+	esInfo := &EsModelInfo{StructName: "Huge"}
+	err := ApplyBudget(esInfo, items, 7, BudgetSample)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int{FamilyMatch: 4, FamilyTerm: 3}
+	for _, item := range items {
+		if item.Limit != want[item.Family] || esInfo.Spec.MaxFuncs[item.Family] != want[item.Family] {
+			t.Errorf("%s: limit %d, max_funcs %d, want %d", item.Family, item.Limit, esInfo.Spec.MaxFuncs[item.Family], want[item.Family])
+		}
+	}
+	if total := PlanTotal(items); total.Cmp(big.NewInt(7)) != 0 {
+		t.Errorf("sampled plan has %s functions, want 7", total)
+	}
+}
+
+func TestGroupCombineLimit(t *testing.T) {
+	// 规格中的分组维度数量超过MaxGroupCombine时按MaxGroupCombine生成
+	for _, name := range exampleMappings {
+		want := generatedFuncs(loadExample(t, name, nil))[FamilyGroup]
+		esInfo := loadExample(t, name, &GenOptions{Spec: &GenSpec{MaxCombine: map[string]int{FamilyGroup: MaxGroupCombine + 3}}})
+		if got := generatedFuncs(esInfo)[FamilyGroup]; got != want {
+			t.Errorf("%s: %d group functions with max_combine %d, want %d", name, got, MaxGroupCombine+3, want)
+		}
+		checkPlan(t, esInfo, PlanFuncs(esInfo))
+
+		// 规格可以减少维度数量
+		esInfo = loadExample(t, name, &GenOptions{Spec: &GenSpec{MaxCombine: map[string]int{FamilyGroup: 1}}})
+		if got := generatedFuncs(esInfo)[FamilyGroup]; got > want {
+			t.Errorf("%s: %d group functions with max_combine 1, want at most %d", name, got, want)
+		}
+		checkPlan(t, esInfo, PlanFuncs(esInfo))
+	}
+}
@@ -0,0 +1,154 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 按字段重要性评分，函数数量受限时保留字段得分最高的组合
+
+// FieldStats 字段的统计信息，key为es字段路径，value为字段的去重值数量
+type FieldStats map[string]int64
+
+// LoadFieldStats 加载字段统计信息文件
+func LoadFieldStats(filePath string) (FieldStats, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read stats file %s: %v", filePath, err)
+	}
+
+	stats := FieldStats{}
+	err = json.Unmarshal(data, &stats)
+	if err != nil {
+		return nil, fmt.Errorf("Error unmarshalling stats file %s: %w", jsonErrorPos(filePath, data, err), err)
+	}
+	return stats, nil
+}
+
+// 各es字段类型的基础得分，检索和过滤常用的类型得分较高
+var typeScores = map[string]float64{
+	"text":    3,
+	"keyword": 3,
+	"date":    2,
+	"integer": 2,
+	"long":    2,
+	"float":   2,
+	"double":  2,
+}
+
+// RankFields 根据meta中的优先级、同义词、字段类型和去重值数量为字段评分
+func RankFields(fields []*FieldInfo, stats FieldStats) error {
+	for _, f := range fields {
+		score := typeScores[f.EsFieldType]
+		if score == 0 {
+			score = 1
+		}
+
+		// 优先级起决定作用
+		if f.Priority != "" {
+			p, err := strconv.Atoi(f.Priority)
+			if err != nil {
+				return fmt.Errorf("Invalid meta priority %q of field %s: %v", f.Priority, f.EsFieldPath, err)
+			}
+			score += float64(p) * 10
+		}
+
+		// 同义词越多，字段越常被提及
+		score += float64(min(len(f.Synonyms), 3))
+
+		// 去重值数量适中的字段更适合作为过滤和分组条件
+		if f.EsFieldType != "text" {
+			score += cardinalityScore(f, stats)
+		}
+
+		f.Score = score
+	}
+	return nil
+}
+
+// cardinalityScore 根据字段的去重值数量评分，无统计信息时参考meta中的基数提示
+func cardinalityScore(f *FieldInfo, stats FieldStats) float64 {
+	n, ok := stats[f.EsFieldPath]
+	if !ok {
+		if f.Cardinality == HighCardinality {
+			return -1
+		}
+		return 0
+	}
+
+	switch {
+	case n <= 1: // 只有一个值，作为条件没有意义
+		return -5
+	case n <= 100:
+		return 3
+	case n <= 10000:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// parseSynonyms 解析meta中逗号分隔的同义词
+func parseSynonyms(synonyms string) []string {
+	words := []string{}
+	for _, w := range strings.Split(synonyms, ",") {
+		w = strings.TrimSpace(w)
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// combinationScore 字段组合的得分，取字段得分的平均值，避免字段数量多的组合得分偏高
+func combinationScore(fields []*FieldInfo) float64 {
+	if len(fields) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, f := range fields {
+		total += f.Score
+	}
+	return total / float64(len(fields))
+}
+
+// funcCollector 收集生成的函数，查询类型设置了函数数量上限时按字段组合得分保留最高的部分
+type funcCollector struct {
+	funcDatas []*FuncTplData
+	top       *utils.TopK[*FuncTplData]
+}
+
+// newFuncCollector 创建函数收集器，限制的函数数量按查询类型每个字段组合生成的函数数量向下取整，与PlanItem.Effective一致
+func newFuncCollector(esInfo *EsModelInfo, family string) *funcCollector {
+	c := &funcCollector{funcDatas: []*FuncTplData{}}
+	if limit := esInfo.Spec.FuncLimit(family); limit > 0 {
+		c.top = utils.NewTopK[*FuncTplData](limit / familyUnit(family))
+	}
+	return c
+}
+
+// Add 加入字段组合对应的函数，build仅在函数会被保留时调用
+func (c *funcCollector) Add(fields []*FieldInfo, build func() *FuncTplData) {
+	if c.top == nil {
+		c.funcDatas = append(c.funcDatas, build())
+		return
+	}
+
+	score := combinationScore(fields)
+	if c.top.Accept(score) {
+		c.top.Push(build(), score)
+	}
+}
+
+// Result 获取收集的函数，保持字段组合的生成顺序
+func (c *funcCollector) Result() []*FuncTplData {
+	if c.top == nil {
+		return c.funcDatas
+	}
+	return c.top.Items()
+}
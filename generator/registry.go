@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// 查询函数生成器的注册表，外部包可以注册自定义的查询类型而无需修改es2go
+
+// Generator 查询函数生成器，每个生成器生成一类查询函数到单独的文件
+type Generator interface {
+	Name() string                               // 查询类型名称，与生成规格的families对应
+	FileSuffix() string                         // 输出文件名的后缀，如_detail_match
+	Prepare(esInfo *EsModelInfo) []*FuncTplData // 使用go代码预处理渲染需要的函数数据
+	Template() string                           // 代码模板，渲染数据为DetailTplData
+}
+
+// templateFiler 可选接口，返回模板目录中可覆盖生成器模板的共用模板文件名
+type templateFiler interface {
+	TemplateFile() string
+}
+
+// funcGenerator 由预处理函数和模板组成的生成器
+type funcGenerator struct {
+	name    string
+	suffix  string
+	prepare func(esInfo *EsModelInfo) []*FuncTplData
+	tpl     string
+	file    string // 内置模板的文件名，多个查询类型共用
+}
+
+func (g *funcGenerator) Name() string         { return g.name }
+func (g *funcGenerator) FileSuffix() string   { return g.suffix }
+func (g *funcGenerator) Template() string     { return g.tpl }
+func (g *funcGenerator) TemplateFile() string { return g.file }
+func (g *funcGenerator) Prepare(esInfo *EsModelInfo) []*FuncTplData {
+	return g.prepare(esInfo)
+}
+
+// NewGenerator 使用预处理函数和模板创建生成器
+func NewGenerator(name, suffix string, prepare func(esInfo *EsModelInfo) []*FuncTplData, tpl string) Generator {
+	return &funcGenerator{name: name, suffix: suffix, prepare: prepare, tpl: tpl}
+}
+
+// newBuiltinGenerator 使用内置模板文件创建生成器
+func newBuiltinGenerator(name, suffix string, prepare func(esInfo *EsModelInfo) []*FuncTplData, file string) Generator {
+	return &funcGenerator{name: name, suffix: suffix, prepare: prepare, tpl: builtinTemplate(file), file: file}
+}
+
+// registryMu 保护generators，批量生成和监视模式中注册与并发渲染可能同时进行
+var registryMu sync.RWMutex
+
+// generators 已注册的生成器，按注册顺序生成
+var generators = []Generator{
+	DetailMatchGenerator,
+	DetailFilterGenerator,
+	DetailRangeGenerator,
+	DetailTermGenerator,
+	AggDistinctGenerator,
+	AggCardinalityGenerator,
+	AggDistributionGenerator,
+	AggGroupGenerator,
+	AggCompareGenerator,
+}
+
+// Register 注册生成器，与已注册的生成器同名时替换原有的生成器，可与渲染并发调用
+func Register(g Generator) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for i, exist := range generators {
+		if exist.Name() == g.Name() {
+			generators[i] = g
+			return
+		}
+	}
+	generators = append(generators, g)
+}
+
+// Generators 按注册顺序获取全部生成器
+func Generators() []Generator {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]Generator{}, generators...)
+}
+
+// Lookup 按名称查找已注册的生成器，不存在时返回nil
+func Lookup(name string) Generator {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for _, g := range generators {
+		if g.Name() == name {
+			return g
+		}
+	}
+	return nil
+}
+
+// Generate 使用生成器渲染查询函数并写入文件，生成规格未启用该查询类型时跳过
+func Generate(g Generator, outputPath string, esInfo *EsModelInfo) error {
+	files, err := Render(g, outputPath, esInfo)
+	if err != nil {
+		return err
+	}
+	return WriteFiles(files)
+}
+
+// Render 使用生成器渲染查询函数，不写入文件，生成规格未启用该查询类型时返回nil。
+// 模板目录中存在<查询类型>.tmpl或生成器共用的模板文件时，优先使用目录中的模板。
+// 函数数量超过布局限定的单个文件最多函数数量时，拆分为多个编号的分片文件。各生成器的函数数量和耗时输出到Debug日志
+func Render(g Generator, outputPath string, esInfo *EsModelInfo) ([]*OutputFile, error) {
+	if !esInfo.Spec.Enabled(g.Name()) {
+		esInfo.logger().Debug("Skipped disabled generator", "generator", g.Name())
+		return nil, nil
+	}
+
+	// 预处理渲染所需的内容
+	start := time.Now()
+	funcData := g.Prepare(esInfo)
+
+	// 加载模板
+	files := []string{g.Name() + ".tmpl"}
+	if tf, ok := g.(templateFiler); ok && tf.TemplateFile() != "" {
+		files = append(files, tf.TemplateFile())
+	}
+	tmpl, err := loadTemplate(g.Name(), esInfo.TmplDir, files, g.Template())
+	if err != nil {
+		return nil, fmt.Errorf("Error loading template of generator %s: %v", g.Name(), err)
+	}
+
+	// 按分片渲染并格式化代码
+	shards := esInfo.Layout.shards(funcData)
+	outputs := []*OutputFile{}
+	for idx, shard := range shards {
+		var buf bytes.Buffer
+		err = tmpl.Execute(&buf, newDetailTplData(esInfo, shard))
+		if err != nil {
+			return nil, fmt.Errorf("Error executing template of generator %s: %v", g.Name(), err)
+		}
+
+		num := 0
+		if len(shards) > 1 {
+			num = idx + 1
+		}
+		path := esInfo.Layout.QueryPath(outputPath, g.Name(), g.FileSuffix(), num)
+		out, err := newOutputFile(g.Name(), path, buf.Bytes(), esInfo.formatOnly)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, out)
+	}
+	esInfo.logger().Debug("Rendered queries", "generator", g.Name(), "funcs", len(funcData), "files", len(outputs), "elapsed", time.Since(start))
+	return outputs, nil
+}
+
+// newDetailTplData 查询函数和通用辅助函数的模板数据，包名和模型类型按输出布局确定
+func newDetailTplData(esInfo *EsModelInfo, funcDatas []*FuncTplData) DetailTplData {
+	data := DetailTplData{
+		PackageName:   esInfo.Layout.queryPackage(esInfo.PackageName),
+		StructName:    esInfo.StructName,
+		StructComment: esInfo.StructComment,
+		IndexName:     esInfo.IndexName,
+		Fields:        esInfo.Fields,
+		FuncDatas:     funcDatas,
+		ModelType:     esInfo.Layout.modelType(esInfo.PackageName, esInfo.StructName),
+	}
+	if esInfo.Layout != nil && esInfo.Layout.SplitPackages {
+		data.ModelImport = esInfo.Layout.ModelImport
+	}
+	return data
+}
@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 查询函数的类型
+const (
+	FamilyMatch        = "match"        // 对text字段检索
+	FamilyFilter       = "filter"       // 以keyword字段过滤后对text字段检索
+	FamilyRange        = "range"        // 数值范围查询
+	FamilyTerm         = "term"         // keyword字段精确查询
+	FamilyDistinct     = "distinct"     // 字段去重值
+	FamilyCardinality  = "cardinality"  // 字段去重数量
+	FamilyDistribution = "distribution" // 数值分布统计
+	FamilyGroup        = "group"        // 多维度分组统计
+	FamilyCompare      = "compare"      // 同比环比统计
+)
+
+// 字段在查询中的角色
+const (
+	RoleMatch  = "match"  // 检索的text字段
+	RoleFilter = "filter" // 精确查询或过滤条件的字段
+	RoleRange  = "range"  // 范围查询的字段
+	RoleGroup  = "group"  // 分组维度、去重统计和时间对比的字段
+	RoleMetric = "metric" // 统计指标的字段
+)
+
+// GenSpec 生成规格，按索引声明启用的查询类型、各角色可用的字段以及组合的范围
+type GenSpec struct {
+	Families       []string            `json:"families" yaml:"families"`               // 启用的查询类型，为空时全部启用
+	Fields         map[string][]string `json:"fields" yaml:"fields"`                   // 各角色可用的字段路径，未配置的角色不限制
+	MaxCombine     map[string]int      `json:"max_combine" yaml:"max_combine"`         // 各查询类型最多组合的字段数量
+	RequiredPairs  [][2]string         `json:"required_pairs" yaml:"required_pairs"`   // 必须同时出现的字段对
+	ForbiddenPairs [][2]string         `json:"forbidden_pairs" yaml:"forbidden_pairs"` // 不能同时出现的字段对
+	MaxFuncs       map[string]int      `json:"max_funcs" yaml:"max_funcs"`             // 各查询类型最多生成的函数数量，超出预算时按比例分配
+}
+
+// LoadGenSpec 加载生成规格文件，.yaml和.yml后缀按YAML解析，其余按JSON解析
+func LoadGenSpec(filePath string) (*GenSpec, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read spec file %s: %v", filePath, err)
+	}
+
+	spec := &GenSpec{}
+	switch filepath.Ext(filePath) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, spec)
+	default:
+		err = json.Unmarshal(data, spec)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error unmarshalling spec file %s: %v", filePath, err)
+	}
+	return spec, nil
+}
+
+// clone 复制生成规格，MaxFuncs单独复制，其余配置只读共享
+func (s *GenSpec) clone() *GenSpec {
+	c := *s
+	if s.MaxFuncs != nil {
+		c.MaxFuncs = maps.Clone(s.MaxFuncs)
+	}
+	return &c
+}
+
+// Enabled 判断查询类型是否启用
+func (s *GenSpec) Enabled(family string) bool {
+	if s == nil || len(s.Families) == 0 {
+		return true
+	}
+	return slices.Contains(s.Families, family)
+}
+
+// CombineLimit 获取查询类型最多组合的字段数量，未配置时使用默认值
+func (s *GenSpec) CombineLimit(family string, def int) int {
+	if s == nil {
+		return def
+	}
+	if n, ok := s.MaxCombine[family]; ok {
+		return n
+	}
+	return def
+}
+
+// FuncLimit 获取查询类型最多生成的函数数量，0表示不限制
+func (s *GenSpec) FuncLimit(family string) int {
+	if s == nil {
+		return 0
+	}
+	return s.MaxFuncs[family]
+}
+
+// RoleFields 过滤出角色可用的字段
+func (s *GenSpec) RoleFields(role string, fields []*FieldInfo) []*FieldInfo {
+	if s == nil {
+		return fields
+	}
+	allowed, ok := s.Fields[role]
+	if !ok {
+		return fields
+	}
+
+	filterout := []*FieldInfo{}
+	for _, f := range fields {
+		if slices.Contains(allowed, f.EsFieldPath) {
+			filterout = append(filterout, f)
+		}
+	}
+	return filterout
+}
+
+// Allow 检查字段组合是否满足必须同时出现和不能同时出现的字段对
+func (s *GenSpec) Allow(groups ...[]*FieldInfo) bool {
+	if s == nil || (len(s.RequiredPairs) == 0 && len(s.ForbiddenPairs) == 0) {
+		return true
+	}
+
+	paths := map[string]bool{}
+	for _, fields := range groups {
+		for _, f := range fields {
+			paths[f.EsFieldPath] = true
+		}
+	}
+
+	for _, p := range s.RequiredPairs {
+		if paths[p[0]] != paths[p[1]] {
+			return false
+		}
+	}
+	for _, p := range s.ForbiddenPairs {
+		if paths[p[0]] && paths[p[1]] {
+			return false
+		}
+	}
+	return true
+}
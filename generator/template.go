@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/kyle-hy/es2go/utils"
+)
+
+// 内置的代码模板，可以通过模板目录中的同名文件覆盖
+//
+// 模板的渲染数据：
+//   - struct.tmpl、struct_wrapper.tmpl：StructTplData，模型结构体定义
+//   - common.tmpl：DetailTplData，FuncDatas为空，各查询函数共用的辅助函数
+//   - detail.tmpl、agg.tmpl及<查询类型>.tmpl：DetailTplData，FuncDatas为生成器预处理的函数数据
+//   - doc.tmpl：DocTplData，--doc生成的doc.go，列出目录中的生成文件
+//
+// 模板可使用的辅助函数见TemplateFuncs。
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// TemplateFuncs 模板可使用的辅助函数
+var TemplateFuncs = template.FuncMap{
+	"camel":      utils.ToCamelCase,  // 下划线转驼峰，首字母小写
+	"pascal":     utils.ToPascalCase, // 下划线转驼峰，首字母大写
+	"lowerFirst": utils.ToFirstLower, // 首字母小写
+	"join":       joinStrings,        // 使用分隔符拼接字符串，如 {{join ", " .List}}
+}
+
+// joinStrings 使用分隔符拼接字符串，分隔符在前以便在模板中使用管道
+func joinStrings(sep string, items []string) string {
+	return strings.Join(items, sep)
+}
+
+// builtinTemplate 读取内置的模板内容
+func builtinTemplate(file string) string {
+	data, err := templateFS.ReadFile("templates/" + file)
+	if err != nil {
+		panic(fmt.Sprintf("builtin template %s not found: %v", file, err))
+	}
+	return string(data)
+}
+
+// loadTemplate 加载代码模板，依次查找模板目录中的files，都不存在时使用text
+func loadTemplate(name, tmplDir string, files []string, text string) (*template.Template, error) {
+	tmpl := template.New(name).Funcs(TemplateFuncs)
+	if tmplDir != "" {
+		for _, file := range files {
+			path := filepath.Join(tmplDir, file)
+			data, err := os.ReadFile(path)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("Failed to read template file %s: %v", path, err)
+			}
+			tmpl, err = tmpl.Parse(string(data))
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing template file %s: %v", path, err)
+			}
+			return tmpl, nil
+		}
+	}
+
+	tmpl, err := tmpl.Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing template %s: %v", name, err)
+	}
+	return tmpl, nil
+}
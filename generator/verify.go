@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// 写入文件前在输出目录所在的模块中对生成的代码做类型检查
+
+// VerifyError 生成代码的类型错误，定位到出错的函数
+type VerifyError struct {
+	File      string // 出错的文件
+	Line      int    // 出错的行号
+	Func      string // 出错的函数名称，不在函数内时为空
+	Generator string // 生成该文件的生成器名称
+	Msg       string // 错误信息
+}
+
+func (e *VerifyError) Error() string {
+	pos := fmt.Sprintf("%s:%d", filepath.Base(e.File), e.Line)
+	if e.Func != "" {
+		pos += " " + e.Func
+	}
+	if e.Generator != "" {
+		pos += " (" + e.Generator + ")"
+	}
+	return pos + ": " + e.Msg
+}
+
+// VerifyFiles 使用模块的依赖对生成的代码和所在包的其他文件做类型检查，代码通过overlay加载，不写入磁盘
+func VerifyFiles(files []*OutputFile) ([]*VerifyError, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	overlay := map[string][]byte{}
+	generators := map[string]string{}
+	for _, f := range files {
+		path, err := filepath.Abs(f.Path)
+		if err != nil {
+			return nil, err
+		}
+		overlay[path] = f.Source
+		generators[path] = f.Generator
+	}
+
+	// 拆分目录时模型和查询函数分别在两个包中。输出目录可能还不存在，go命令在已存在的上级目录中运行，
+	// 不存在的目录中的文件只存在于overlay中，不创建目录
+	dirs := []string{}
+	for _, f := range files {
+		dir, err := filepath.Abs(filepath.Dir(f.Path))
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	cfg := &packages.Config{
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:     existingDir(dirs[0]),
+		Overlay: overlay,
+	}
+	pkgs, err := packages.Load(cfg, dirs...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load packages in %s: %v", strings.Join(dirs, ", "), err)
+	}
+
+	verrs := []*VerifyError{}
+	funcs := map[string][]*funcRange{}
+	for _, pkg := range pkgs {
+		for _, e := range pkgErrors(pkg) {
+			verr := &VerifyError{Msg: e.Msg}
+			verr.File, verr.Line = splitPos(e.Pos)
+			if src, ok := overlay[verr.File]; ok {
+				if _, parsed := funcs[verr.File]; !parsed {
+					funcs[verr.File] = parseFuncRanges(verr.File, src)
+				}
+				verr.Func = findFunc(funcs[verr.File], verr.Line)
+				verr.Generator = generators[verr.File]
+			}
+			verrs = append(verrs, verr)
+		}
+	}
+	return verrs, nil
+}
+
+// existingDir 目录或其最近的已存在的上级目录
+func existingDir(dir string) string {
+	for {
+		info, err := os.Stat(dir)
+		if err == nil && info.IsDir() {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
+
+// pkgErrors 获取包的错误，存在类型错误时忽略go命令输出的重复编译错误
+func pkgErrors(pkg *packages.Package) []packages.Error {
+	typeErrs := []packages.Error{}
+	for _, e := range pkg.Errors {
+		if e.Kind == packages.TypeError {
+			typeErrs = append(typeErrs, e)
+		}
+	}
+	if len(typeErrs) > 0 {
+		return typeErrs
+	}
+	return pkg.Errors
+}
+
+// splitPos 拆分file:line:col或file:line格式的错误位置，文件路径中可能含有冒号
+func splitPos(pos string) (string, int) {
+	parts := strings.Split(pos, ":")
+	n := len(parts)
+	if n >= 3 {
+		line, err1 := strconv.Atoi(parts[n-2])
+		_, err2 := strconv.Atoi(parts[n-1])
+		if err1 == nil && err2 == nil {
+			return strings.Join(parts[:n-2], ":"), line
+		}
+	}
+	if n >= 2 {
+		line, err := strconv.Atoi(parts[n-1])
+		if err == nil {
+			return strings.Join(parts[:n-1], ":"), line
+		}
+	}
+	return pos, 0
+}
+
+// funcRange 函数声明所在的行范围
+type funcRange struct {
+	name       string
+	start, end int
+}
+
+// parseFuncRanges 解析代码中各函数声明所在的行范围
+func parseFuncRanges(filename string, src []byte) []*funcRange {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	ranges := []*funcRange{}
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		ranges = append(ranges, &funcRange{
+			name:  fd.Name.Name,
+			start: fset.Position(fd.Pos()).Line,
+			end:   fset.Position(fd.End()).Line,
+		})
+	}
+	return ranges
+}
+
+// findFunc 查找行号所在的函数名称
+func findFunc(ranges []*funcRange, line int) string {
+	for _, r := range ranges {
+		if line >= r.start && line <= r.end {
+			return r.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// 监视模式：轮询mapping、配置和模板文件的修改时间和大小，连续修改平息后再返回修改的文件，
+// 避免编辑器保存时的多次写入触发多次生成
+
+// fileStamp 文件的修改时间和大小，文件不存在时不记录
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// Watcher 轮询方式监视文件的修改，路径为目录时监视目录中的文件(不递归)
+type Watcher struct {
+	Interval time.Duration // 轮询的间隔
+	Debounce time.Duration // 最后一次修改后等待的时间，期间的修改合并为一次
+	paths    []string
+	stamps   map[string]fileStamp
+}
+
+// NewWatcher 创建监视指定文件和目录的Watcher，以当前的状态为基准
+func NewWatcher(paths []string, interval, debounce time.Duration) *Watcher {
+	w := &Watcher{Interval: interval, Debounce: debounce, paths: paths}
+	w.stamps = w.scan()
+	return w
+}
+
+// Wait 阻塞直到监视的文件发生修改且在Debounce时间内没有新的修改，返回修改、新增或删除的文件
+func (w *Watcher) Wait() []string {
+	changed := map[string]bool{}
+	var lastChange time.Time
+	for {
+		time.Sleep(w.Interval)
+		stamps := w.scan()
+		diff := diffStamps(w.stamps, stamps)
+		w.stamps = stamps
+		for _, path := range diff {
+			changed[path] = true
+		}
+		if len(diff) > 0 {
+			lastChange = time.Now()
+			continue
+		}
+		if len(changed) > 0 && time.Since(lastChange) >= w.Debounce {
+			break
+		}
+	}
+
+	paths := make([]string, 0, len(changed))
+	for path := range changed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// scan 获取监视的文件的当前状态
+func (w *Watcher) scan() map[string]fileStamp {
+	stamps := map[string]fileStamp{}
+	for _, path := range w.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if !info.IsDir() {
+			stamps[path] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			info, err := e.Info()
+			if err != nil {
+				continue
+			}
+			stamps[filepath.Join(path, e.Name())] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return stamps
+}
+
+// diffStamps 比较两次轮询的状态，返回发生变化的文件
+func diffStamps(before, after map[string]fileStamp) []string {
+	changed := []string{}
+	for path, stamp := range after {
+		if prev, ok := before[path]; !ok || !prev.modTime.Equal(stamp.modTime) || prev.size != stamp.size {
+			changed = append(changed, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+// WatchPaths 生成任务依赖的输入文件和目录：mapping、映射配置、模板、生成规格和字段统计
+func (job *BatchJob) WatchPaths() []string {
+	paths := []string{job.InputPath}
+	if job.Options == nil {
+		return paths
+	}
+	opts := job.Options
+	for _, p := range []*string{
+		opts.TypeMappingPath, opts.ExceptionFieldPath, opts.ExceptionTypePath, opts.SkipFieldPath, opts.FieldCommentPath,
+		opts.TmplPath, opts.TmplDir, opts.SpecPath, opts.StatsPath,
+	} {
+		if p != nil && *p != "" {
+			paths = append(paths, *p)
+		}
+	}
+	return paths
+}
+
+// DependsOn 判断生成任务是否依赖指定的文件，文件在依赖的目录中时也视为依赖
+func (job *BatchJob) DependsOn(path string) bool {
+	for _, p := range job.WatchPaths() {
+		if filepath.Clean(p) == filepath.Clean(path) || filepath.Clean(p) == filepath.Dir(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// FuncNames 渲染结果中查询函数的名称，不含模型和通用辅助函数，与Funcs的统计范围一致
+func (r *BatchResult) FuncNames() map[string]bool {
+	names := map[string]bool{}
+	for _, f := range r.Files {
+		if f.Generator == "model" || f.Generator == "common" {
+			continue
+		}
+		decls, err := parseDecls(f.Path, f.Source)
+		if err != nil {
+			continue
+		}
+		for _, name := range decls.Funcs {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// DiffFuncs 比较两次渲染结果的函数，返回新增和删除的函数名称，before为空时全部视为新增
+func DiffFuncs(before, after *BatchResult) (added, removed []string) {
+	prev := map[string]bool{}
+	if before != nil {
+		prev = before.FuncNames()
+	}
+	cur := after.FuncNames()
+	for name := range cur {
+		if !prev[name] {
+			added = append(added, name)
+		}
+	}
+	for name := range prev {
+		if !cur[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
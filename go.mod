@@ -8,6 +8,8 @@ require (
 	github.com/elastic/go-elasticsearch/v8 v8.17.1
 	github.com/kyle-hy/esquery v1.0.4
 	golang.org/x/text v0.23.0
+	golang.org/x/tools v0.31.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -17,4 +19,6 @@ require (
 	go.opentelemetry.io/otel v1.28.0 // indirect
 	go.opentelemetry.io/otel/metric v1.28.0 // indirect
 	go.opentelemetry.io/otel/trace v1.28.0 // indirect
+	golang.org/x/mod v0.24.0 // indirect
+	golang.org/x/sync v0.12.0 // indirect
 )
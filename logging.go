@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// 日志：输出到标准错误，-v输出各生成器的函数数量和耗时，-q只输出警告和错误，--log-format json便于CI收集
+
+// addLogFlags 在参数集中注册日志参数
+func addLogFlags(fs *flag.FlagSet) {
+	fs.Bool("v", false, "Verbose logging, including the function count and duration of every generator")
+	fs.Bool("q", false, "Quiet logging, only warnings and errors")
+	fs.String("log-format", "text", "Log format: text or json")
+}
+
+// parseFlags 解析命令行参数并按日志参数设置默认的日志
+func parseFlags(fs *flag.FlagSet, args []string) {
+	fs.Parse(args)
+	verbose := fs.Lookup("v").Value.String() == "true"
+	quiet := fs.Lookup("q").Value.String() == "true"
+	if verbose && quiet {
+		fmt.Fprintf(fs.Output(), "-v and -q are mutually exclusive\n")
+		os.Exit(2)
+	}
+
+	level := slog.LevelInfo
+	switch {
+	case verbose:
+		level = slog.LevelDebug
+	case quiet:
+		level = slog.LevelWarn
+	}
+	opts := &slog.HandlerOptions{Level: level}
+	switch format := fs.Lookup("log-format").Value.String(); format {
+	case "text":
+		slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, opts)))
+	case "json":
+		slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, opts)))
+	default:
+		fmt.Fprintf(fs.Output(), "unknown log format %q, use text or json\n", format)
+		os.Exit(2)
+	}
+}
+
+// fatal 输出错误日志后以1退出
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
@@ -1,8 +1,13 @@
 package main
 
 import (
-	"flag"
-	"log"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
 
 	gen "github.com/kyle-hy/es2go/generator"
 )
@@ -16,57 +21,259 @@ func GetOrDefault[T any](ptr *T, defaultVal T) T {
 }
 
 func main() {
-	// required arguments
-	inputPath := flag.String("in", "", "Input JSON schema file (including file name)")
-	outputPath := flag.String("out", "", "Output Go file (including file name)")
-	packageName := flag.String("package", "model", "Name of the Go package")
-	structName := flag.String("struct", "GeneratedStruct", "Name of the generated Go struct")
-
-	// optional arguments
-	initClassName := flag.String("init", "", "Name of the initial wrapper struct (optional)")
-	typeMappingPath := flag.String("type-mapping", "", "Path to JSON file specifying Elasticsearch to Go type mapping")
-	exceptionFieldPath := flag.String("exception-field", "", "Path to JSON file specifying exceptions for field names")
-	exceptionTypePath := flag.String("exception-type", "", "Path to JSON file specifying exceptions for field types")
-	skipFieldPath := flag.String("skip-field", "", "Path to JSON file specifying fields to skip")
-	fieldCommentPath := flag.String("field-comment", "", "Path to JSON file specifying comments for fields")
-	tmplPath := flag.String("tmpl", "", "Path to custom Go template file")
-
-	flag.Parse()
-
-	// validate required arguments
-	if *inputPath == "" || *outputPath == "" || *structName == "" || *packageName == "" {
-		log.Fatalf("All --in, --out, --struct, and --package must be specified")
-	}
-
-	// set up generator options
-	opts := &gen.GenOptions{
-		InitClassName:      nullableString(initClassName),
-		TypeMappingPath:    nullableString(typeMappingPath),
-		ExceptionFieldPath: nullableString(exceptionFieldPath),
-		ExceptionTypePath:  nullableString(exceptionTypePath),
-		SkipFieldPath:      nullableString(skipFieldPath),
-		FieldCommentPath:   nullableString(fieldCommentPath),
-		TmplPath:           nullableString(tmplPath),
+	// es2go <command> [flags]，不指定子命令时与generate相同，兼容原有的用法
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		cmd := findCommand(os.Args[1])
+		if cmd == nil {
+			fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+			printUsage(os.Stderr)
+			os.Exit(2)
+		}
+		cmd.run(cmd.name, os.Args[2:])
+		return
+	}
+	runGenerate("es2go", os.Args[1:])
+}
+
+// runGenerate 生成模型结构体、通用辅助函数和全部查询函数，支持批量生成、监视模式、类型检查和过期检查
+func runGenerate(name string, args []string) {
+	fs := newFlagSet(name, "Generate the model struct, the common helpers and every query family")
+	f := newMappingFlags(fs)
+	plan := fs.Bool("plan", false, "Print the number of functions each generator would emit without generating code")
+	verify := fs.Bool("verify", false, "Type-check the generated package before writing, leaving existing files untouched on failure")
+	check := fs.Bool("check", false, "Render in memory, compare with the files on disk and exit non-zero with a unified diff when they differ")
+	dryRun := fs.Bool("dry-run", false, "Print the files that would be written without writing them")
+	batch := fs.String("batch", "", "Generate many indices in one run from a manifest (es2go.yaml/.json) or a directory/glob of mapping files")
+	jobs := fs.Int("jobs", runtime.NumCPU(), "Number of indices rendered concurrently in --batch mode")
+	force := fs.Bool("force", false, "Regenerate even when the manifest shows that the inputs are unchanged")
+	reportPath := fs.String("report", "", "Write a JSON report of the files and functions generated per index in --batch mode")
+	watch := fs.Bool("watch", false, "Watch the mappings, config and templates and regenerate the affected indices when they change")
+	watchInterval := fs.Duration("watch-interval", 500*time.Millisecond, "Polling interval of --watch")
+	watchDebounce := fs.Duration("watch-debounce", 300*time.Millisecond, "Time without further changes before --watch regenerates")
+	f.parse(args)
+
+	// 加载生成任务：批量生成时为配置文件中的全部索引或目录中的全部mapping文件，否则为单个索引
+	loadJobs := func() ([]*gen.BatchJob, error) {
+		if *batch != "" {
+			return f.loadBatchJobs(*batch)
+		}
+		job, err := f.loadJob()
+		if err != nil {
+			return nil, err
+		}
+		return []*gen.BatchJob{job}, nil
+	}
+	batchOpts := gen.BatchOptions{Workers: *jobs, Budget: *f.budget, BudgetMode: *f.budgetMode, Force: *force}
+
+	// 监视输入文件，修改后重新生成受影响的索引
+	if *watch {
+		roots := []string{}
+		if *f.configPath != "" {
+			roots = append(roots, *f.configPath)
+		}
+		if *batch != "" {
+			roots = append(roots, batchWatchRoot(*batch))
+		}
+		runWatch(loadJobs, roots, batchOpts, *watchInterval, *watchDebounce)
+		return
+	}
+
+	// 批量生成多个索引
+	if *batch != "" {
+		batchJobs, err := loadJobs()
+		if err != nil {
+			fatal(err.Error())
+		}
+		runBatch(batchJobs, batchOpts, *reportPath, *dryRun)
+		return
 	}
 
+	job, err := f.loadJob()
+	if err != nil {
+		fatal(err.Error())
+	}
+	opts := job.Options
+	opts.SkipWrite = true
+
 	// 生成struct结构体定义
-	esInfo, err := gen.GenEsModel(*inputPath, *outputPath, *packageName, *structName, opts)
+	esInfo, err := gen.GenEsModel(job.InputPath, job.OutputPath, job.PackageName, job.StructName, opts)
+	if err != nil {
+		fatal("Failed to generate data model", "err", err)
+	}
+
+	// 估算各查询类型的函数数量，检查预算
+	planItems := gen.PlanFuncs(esInfo)
+	if *plan {
+		gen.PrintPlan(os.Stdout, esInfo, planItems)
+	}
+	err = gen.ApplyBudget(esInfo, planItems, *f.budget, *f.budgetMode)
+	if err != nil {
+		fatal("Budget exceeded", "err", err)
+	}
+	if *plan {
+		if *f.budget > 0 && *f.budgetMode == gen.BudgetSample {
+			gen.PrintPlan(os.Stdout, esInfo, planItems)
+		}
+		return
+	}
+
+	// 只比较或列出文件，不写入
+	if *check || *dryRun {
+		compareFiles(job.OutputPath, esInfo, *check)
+		return
+	}
+
+	// 类型检查通过后再写入全部文件
+	if *verify {
+		verifyAndWrite(job.OutputPath, esInfo)
+		return
+	}
+
+	// 输入未变化且文件未被修改时跳过生成
+	hash, err := gen.InputHash(esInfo)
+	if err != nil {
+		fatal("Failed to hash inputs", "err", err)
+	}
+	if !*force {
+		fresh, err := gen.LoadUpToDate(job.OutputPath, esInfo, hash)
+		if err != nil {
+			fatal("Failed to load manifest", "err", err)
+		}
+		if fresh != nil {
+			slog.Info("Up to date", "model", esInfo.StructName, "unchanged", len(fresh))
+			return
+		}
+	}
+
+	// 渲染模型结构体、各查询函数共用的辅助函数和已注册生成器的查询函数，某个生成器失败时继续生成其他的文件，最后以非0退出
+	files, failed := renderQueries(job.OutputPath, esInfo, nil)
+	files = append([]*gen.OutputFile{{Path: esInfo.ModelPath, Generator: "model", Source: esInfo.ModelSource}}, files...)
+
+	// 只写入内容变化的文件，全部生成成功时删除不再生成的文件
+	failed += writeFiles(job.OutputPath, esInfo, hash, files, failed == 0)
+	if failed > 0 {
+		fatal("Generation failed", "model", esInfo.StructName, "errors", failed)
+	}
+}
+
+// renderQueries 渲染通用辅助函数和指定查询类型的查询函数，families为空时渲染全部已注册的生成器，
+// 某个生成器失败时继续渲染其他的生成器，返回渲染的文件和失败的数量
+func renderQueries(outputPath string, esInfo *gen.EsModelInfo, families []string) ([]*gen.OutputFile, int) {
+	failed := 0
+	files := []*gen.OutputFile{}
+	common, err := gen.RenderCommon(outputPath, esInfo)
+	if err != nil {
+		slog.Error("Failed to generate common helpers", "model", esInfo.StructName, "err", err)
+		failed++
+	} else {
+		files = append(files, common)
+	}
+	for _, g := range gen.Generators() {
+		if len(families) > 0 && !slices.Contains(families, g.Name()) {
+			continue
+		}
+		rendered, err := gen.Render(g, outputPath, esInfo)
+		if err != nil {
+			slog.Error("Failed to generate queries", "model", esInfo.StructName, "generator", g.Name(), "err", err)
+			failed++
+			continue
+		}
+		files = append(files, rendered...)
+	}
+	return files, failed
+}
+
+// writeFiles 只写入内容变化的文件并更新清单，prune为true时删除不再生成的文件，布局启用时生成doc.go，返回失败的数量
+func writeFiles(outputPath string, esInfo *gen.EsModelInfo, hash string, files []*gen.OutputFile, prune bool) int {
+	failed := 0
+	stats, err := gen.WriteIncremental(outputPath, esInfo, hash, files, prune)
 	if err != nil {
-		log.Fatalf("Failed to generate data model: %v", err)
+		slog.Error("Failed to write generated code", "model", esInfo.StructName, "err", err)
+		failed++
+	}
+	if stats != nil {
+		slog.Info("Wrote files", "model", esInfo.StructName, "written", len(stats.Written), "unchanged", len(stats.Unchanged), "removed", len(stats.Deleted))
 	}
 
-	// 生成详情查询函数接口
-	gen.GenEsDetailMatch(*outputPath, esInfo)
-	gen.GenEsDetailFilter(*outputPath, esInfo)
-	gen.GenEsDetailRange(*outputPath, esInfo)
-	gen.GenEsDetailTerm(*outputPath, esInfo)
+	// 生成列出目录内容的doc.go
+	if esInfo.Layout.Doc {
+		err = gen.GenEsDocs(outputPath, esInfo)
+		if err != nil {
+			slog.Error("Failed to generate doc.go", "model", esInfo.StructName, "err", err)
+			failed++
+		}
+	}
+	return failed
+}
+
+// verifyAndWrite 渲染全部代码并做类型检查，存在错误时不写入任何文件
+func verifyAndWrite(outputPath string, esInfo *gen.EsModelInfo) {
+	files, err := gen.RenderAll(outputPath, esInfo)
+	if err != nil {
+		fatal("Failed to render generated code", "err", err)
+	}
+
+	verrs, err := gen.VerifyFiles(files)
+	if err != nil {
+		fatal("Failed to verify generated code", "err", err)
+	}
+	if len(verrs) > 0 {
+		for _, verr := range verrs {
+			slog.Error("Type error", "err", verr)
+		}
+		fatal("Generated code has type errors, no files were written", "errors", len(verrs))
+	}
 
+	hash, err := gen.InputHash(esInfo)
+	if err != nil {
+		fatal("Failed to hash inputs", "err", err)
+	}
+	stats, err := gen.WriteIncremental(outputPath, esInfo, hash, files, true)
+	if err != nil {
+		fatal("Failed to write generated code", "err", err)
+	}
+	if esInfo.Layout.Doc {
+		err = gen.GenEsDocs(outputPath, esInfo)
+		if err != nil {
+			fatal("Failed to generate doc.go", "err", err)
+		}
+	}
+	slog.Info("Verified and wrote files", "model", esInfo.StructName, "written", len(stats.Written), "unchanged", len(stats.Unchanged), "removed", len(stats.Deleted))
 }
 
-// nullableString is a helper function to treat flag.String values as nullable.
-func nullableString(flagValue *string) *string {
-	if *flagValue == "" {
-		return nil
+// compareFiles 渲染全部代码并与磁盘上的文件比较，check为true时输出差异并在存在差异时以非0退出，否则只列出将要写入的文件
+func compareFiles(outputPath string, esInfo *gen.EsModelInfo, check bool) {
+	files, err := gen.RenderAll(outputPath, esInfo)
+	if err != nil {
+		fatal("Failed to render generated code", "err", err)
+	}
+
+	diffs, err := gen.CompareFiles(files)
+	if err != nil {
+		fatal("Failed to compare generated code", "err", err)
+	}
+	// 重新生成时会删除的文件同样视为过期
+	orphans, err := gen.CompareOrphans(outputPath, esInfo, files)
+	if err != nil {
+		fatal("Failed to compare generated code", "err", err)
+	}
+
+	stale := 0
+	for idx, d := range append(diffs, orphans...) {
+		if !check {
+			if d.Status == gen.FileDelete {
+				fmt.Printf("%-9s %s\n", d.Status, d.Path)
+			} else {
+				fmt.Printf("%-9s %s (%d bytes)\n", d.Status, d.Path, len(files[idx].Source))
+			}
+			continue
+		}
+		if d.Status != gen.FileUnchanged {
+			stale++
+			fmt.Print(d.Diff)
+		}
+	}
+	if stale > 0 {
+		fatal("Generated files are out of date", "model", esInfo.StructName, "stale", stale, "files", len(diffs)+len(orphans))
 	}
-	return flagValue
 }
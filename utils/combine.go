@@ -1,6 +1,9 @@
 package utils
 
-import "strings"
+import (
+	"math/big"
+	"strings"
+)
 
 // FilterOut 从source中踢出exclude的元素
 func FilterOut[T comparable](source, exclude []T) []T {
@@ -83,3 +86,48 @@ func Cartesian(data [][]string) []string {
 	backtrack(0, []string{})
 	return result
 }
+
+// EachCombination 按组合元素数量从小到大依次遍历数组元素的组合，顺序与Combinations一致，
+// 不预先生成全部组合，fn返回false时停止遍历
+func EachCombination[T any](items []T, maxCount int, fn func(comb []T) bool) bool {
+	n := len(items)
+	for k := 1; k <= maxCount && k <= n; k++ {
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+
+		for {
+			comb := make([]T, k)
+			for i, j := range idx {
+				comb[i] = items[j]
+			}
+			if !fn(comb) {
+				return false
+			}
+
+			// 推进到下一个组合的下标
+			i := k - 1
+			for i >= 0 && idx[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				break
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+	return true
+}
+
+// CombinationCount 计算n个元素中取1到maxCount个元素的组合总数，不生成具体组合
+func CombinationCount(n, maxCount int) *big.Int {
+	total := big.NewInt(0)
+	for k := 1; k <= maxCount && k <= n; k++ {
+		total.Add(total, new(big.Int).Binomial(int64(n), int64(k)))
+	}
+	return total
+}
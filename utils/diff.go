@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffEdit 逐行比较的编辑操作，op为' '、'-'或'+'
+type diffEdit struct {
+	op   byte
+	line string
+}
+
+// UnifiedDiff 生成两段文本的unified diff，内容相同时返回空字符串
+func UnifiedDiff(aName, bName, a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	edits := diffLines(splitLines(a), splitLines(b))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", aName, bName)
+	for _, h := range diffHunks(edits, 3) {
+		sb.WriteString(h)
+	}
+	return sb.String()
+}
+
+// splitLines 按行拆分文本，保留末尾没有换行符的最后一行
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines 使用Myers算法计算两组文本行的最短编辑序列，先去掉相同的首尾行以减少计算量
+func diffLines(a, b []string) []diffEdit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits := []diffEdit{}
+	for _, l := range a[:prefix] {
+		edits = append(edits, diffEdit{' ', l})
+	}
+	edits = append(edits, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		edits = append(edits, diffEdit{' ', l})
+	}
+	return edits
+}
+
+// diffPoint 编辑图中的点，x为a的行号，y为b的行号
+type diffPoint struct {
+	x, y int
+}
+
+// myers 线性空间的Myers差分算法：在编辑图中查找最短编辑路径的中间蛇，再递归处理两侧，
+// 得到路径上的一系列点后转换为编辑序列，内存为O(n+m)
+func myers(a, b []string) []diffEdit {
+	edits := make([]diffEdit, 0, max(len(a), len(b)))
+	path := myersPath(a, b, 0, 0, len(a), len(b))
+	for i := 1; i < len(path); i++ {
+		x, y := path[i-1].x, path[i-1].y
+		next := path[i]
+		for x < next.x && y < next.y && a[x] == b[y] {
+			edits = append(edits, diffEdit{' ', a[x]})
+			x++
+			y++
+		}
+		switch {
+		case next.x-x < next.y-y:
+			edits = append(edits, diffEdit{'+', b[y]}) // 向下移动，插入b的行
+			y++
+		case next.x-x > next.y-y:
+			edits = append(edits, diffEdit{'-', a[x]}) // 向右移动，删除a的行
+			x++
+		}
+		for x < next.x && y < next.y && a[x] == b[y] {
+			edits = append(edits, diffEdit{' ', a[x]})
+			x++
+			y++
+		}
+	}
+	return edits
+}
+
+// myersPath 计算a[left:right]和b[top:bottom]之间最短编辑路径上的点，区域为空时返回nil
+func myersPath(a, b []string, left, top, right, bottom int) []diffPoint {
+	start, finish, ok := middleSnake(a, b, left, top, right, bottom)
+	if !ok {
+		return nil
+	}
+	head := myersPath(a, b, left, top, start.x, start.y)
+	if head == nil {
+		head = []diffPoint{start}
+	}
+	tail := myersPath(a, b, finish.x, finish.y, right, bottom)
+	if tail == nil {
+		tail = []diffPoint{finish}
+	}
+	return append(head, tail...)
+}
+
+// middleSnake 从两端同时搜索，返回最短编辑路径中间的一段蛇(一次插入或删除及其后的相同行)的起点和终点。
+// vf记录正向搜索在各对角线k上到达的最远x，vb记录反向搜索在各对角线c上到达的最小y
+func middleSnake(a, b []string, left, top, right, bottom int) (diffPoint, diffPoint, bool) {
+	width, height := right-left, bottom-top
+	size := width + height
+	if size == 0 {
+		return diffPoint{}, diffPoint{}, false
+	}
+
+	maxD := (size + 1) / 2
+	offset := maxD + 1
+	vf := make([]int, 2*maxD+3)
+	vb := make([]int, 2*maxD+3)
+	vf[offset+1] = left
+	vb[offset+1] = bottom
+	delta := width - height
+
+	for d := 0; d <= maxD; d++ {
+		// 正向搜索
+		for k := d; k >= -d; k -= 2 {
+			c := k - delta
+			var px, x int
+			if k == -d || (k != d && vf[offset+k-1] < vf[offset+k+1]) {
+				px = vf[offset+k+1]
+				x = px
+			} else {
+				px = vf[offset+k-1]
+				x = px + 1
+			}
+			y := top + (x - left) - k
+			py := y
+			if d != 0 && x == px {
+				py = y - 1
+			}
+			for x < right && y < bottom && a[x] == b[y] {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+			if delta%2 != 0 && c >= -(d-1) && c <= d-1 && y >= vb[offset+c] {
+				return diffPoint{px, py}, diffPoint{x, y}, true
+			}
+		}
+
+		// 反向搜索
+		for c := d; c >= -d; c -= 2 {
+			k := c + delta
+			var py, y int
+			if c == -d || (c != d && vb[offset+c-1] > vb[offset+c+1]) {
+				py = vb[offset+c+1]
+				y = py
+			} else {
+				py = vb[offset+c-1]
+				y = py - 1
+			}
+			x := left + (y - top) + k
+			px := x
+			if d != 0 && y == py {
+				px = x + 1
+			}
+			for x > left && y > top && a[x-1] == b[y-1] {
+				x--
+				y--
+			}
+			vb[offset+c] = y
+			if delta%2 == 0 && k >= -d && k <= d && x <= vf[offset+k] {
+				return diffPoint{x, y}, diffPoint{px, py}, true
+			}
+		}
+	}
+	return diffPoint{}, diffPoint{}, false
+}
+
+// diffHunks 将编辑序列按变化位置分组，每组保留context行上下文
+func diffHunks(edits []diffEdit, context int) []string {
+	// 各编辑操作之前在两段文本中的行数
+	aPos, bPos := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for idx, e := range edits {
+		aPos[idx+1], bPos[idx+1] = aPos[idx], bPos[idx]
+		if e.op != '+' {
+			aPos[idx+1]++
+		}
+		if e.op != '-' {
+			bPos[idx+1]++
+		}
+	}
+
+	hunks := []string{}
+	i := 0
+	for i < len(edits) {
+		// 查找下一处变化
+		for i < len(edits) && edits[i].op == ' ' {
+			i++
+		}
+		if i >= len(edits) {
+			break
+		}
+
+		start := max(i-context, 0)
+		end := i
+		for end < len(edits) {
+			if edits[end].op != ' ' {
+				end++
+				continue
+			}
+			// 相同的行超过两倍上下文时结束当前分组
+			same := end
+			for same < len(edits) && edits[same].op == ' ' {
+				same++
+			}
+			if same == len(edits) || same-end > 2*context {
+				end = min(end+context, len(edits))
+				break
+			}
+			end = same
+		}
+
+		// 分组在两段文本中的起始行号和行数
+		aStart, bStart := aPos[start]+1, bPos[start]+1
+		aLines, bLines := aPos[end]-aPos[start], bPos[end]-bPos[start]
+		var body strings.Builder
+		for _, e := range edits[start:end] {
+			body.WriteByte(e.op)
+			body.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				body.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		hunks = append(hunks, fmt.Sprintf("@@ -%s +%s @@\n%s", hunkRange(aStart, aLines), hunkRange(bStart, bLines), body.String()))
+		i = end
+	}
+	return hunks
+}
+
+// hunkRange 格式化分组的行范围，行数为0时起始行号取前一行
+func hunkRange(start, lines int) string {
+	if lines == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if lines == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, lines)
+}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// numberedLines 生成编号为from到to(不含)的文本行
+func numberedLines(from, to int) string {
+	var sb strings.Builder
+	for i := from; i < to; i++ {
+		fmt.Fprintf(&sb, "line %d\n", i)
+	}
+	return sb.String()
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{
+			name: "identical",
+			a:    "a\nb\n",
+			b:    "a\nb\n",
+			want: "",
+		},
+		{
+			name: "both empty",
+			want: "",
+		},
+		{
+			name: "add to empty",
+			b:    "a\nb\n",
+			want: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "remove all",
+			a:    "a\n",
+			want: "--- a\n+++ b\n@@ -1 +0,0 @@\n-a\n",
+		},
+		{
+			name: "change with context",
+			a:    numberedLines(1, 11),
+			b:    strings.Replace(numberedLines(1, 11), "line 5\n", "line five\n", 1),
+			want: "--- a\n+++ b\n@@ -2,7 +2,7 @@\n line 2\n line 3\n line 4\n-line 5\n+line five\n line 6\n line 7\n line 8\n",
+		},
+		{
+			name: "separate hunks",
+			a:    numberedLines(1, 21),
+			b:    strings.NewReplacer("line 2\n", "", "line 18\n", "line 18\nline 18.5\n").Replace(numberedLines(1, 21)),
+			want: "--- a\n+++ b\n@@ -1,5 +1,4 @@\n line 1\n-line 2\n line 3\n line 4\n line 5\n" +
+				"@@ -16,5 +15,6 @@\n line 16\n line 17\n line 18\n+line 18.5\n line 19\n line 20\n",
+		},
+		{
+			name: "merged hunks",
+			a:    numberedLines(1, 11),
+			b:    strings.NewReplacer("line 3\n", "line three\n", "line 8\n", "line eight\n").Replace(numberedLines(1, 11)),
+			want: "--- a\n+++ b\n@@ -1,10 +1,10 @@\n line 1\n line 2\n-line 3\n+line three\n line 4\n line 5\n line 6\n line 7\n-line 8\n+line eight\n line 9\n line 10\n",
+		},
+		{
+			name: "no newline at end",
+			a:    "a\nb",
+			b:    "a\nc",
+			want: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff("a", "b", tt.a, tt.b); got != tt.want {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+// lcsLen 动态规划计算最长公共子序列的长度，用于检查编辑序列是否最短
+func lcsLen(a, b []string) int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(cur[j], prev[j+1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// checkEdits 检查编辑序列可以还原出两组文本行，且编辑次数最少
+func checkEdits(t *testing.T, a, b []string, edits []diffEdit) {
+	t.Helper()
+	var gotA, gotB []string
+	changes := 0
+	for _, e := range edits {
+		if e.op != '+' {
+			gotA = append(gotA, e.line)
+		}
+		if e.op != '-' {
+			gotB = append(gotB, e.line)
+		}
+		if e.op != ' ' {
+			changes++
+		}
+	}
+	if strings.Join(gotA, "") != strings.Join(a, "") || strings.Join(gotB, "") != strings.Join(b, "") {
+		t.Fatalf("edits do not reproduce the input\na: %q\nb: %q\nedits: %q", a, b, edits)
+	}
+	if want := len(a) + len(b) - 2*lcsLen(a, b); changes != want {
+		t.Fatalf("edits have %d changes, want %d\na: %q\nb: %q", changes, want, a, b)
+	}
+}
+
+func TestMyersShortest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a'+r.Intn(4))) + "\n"
+		}
+		return lines
+	}
+	for i := 0; i < 2000; i++ {
+		a, b := randomLines(), randomLines()
+		checkEdits(t, a, b, myers(a, b))
+		checkEdits(t, a, b, diffLines(a, b))
+	}
+}
+
+func TestUnifiedDiffLarge(t *testing.T) {
+	// 7000行与9600行、差异分散的文件，逐步保存完整对角线数组的实现会耗尽内存
+	r := rand.New(rand.NewSource(2))
+	var a, b strings.Builder
+	for i := 0; i < 9600; i++ {
+		if i < 7000 {
+			fmt.Fprintf(&a, "line %d %d\n", i, r.Intn(3))
+		}
+		fmt.Fprintf(&b, "line %d %d\n", i, r.Intn(3))
+	}
+
+	diff := UnifiedDiff("a", "b", a.String(), b.String())
+	added, removed := 0, 0
+	for _, l := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"):
+		case strings.HasPrefix(l, "+"):
+			added++
+		case strings.HasPrefix(l, "-"):
+			removed++
+		}
+	}
+	if added-removed != 2600 {
+		t.Errorf("diff adds %d and removes %d lines, want a net of 2600 added lines", added, removed)
+	}
+}
@@ -9,6 +9,9 @@ import (
 
 // ToFirstLower 将驼峰模式的首字母小写
 func ToFirstLower(s string) string {
+	if s == "" {
+		return s
+	}
 	return strings.ToLower(s[:1]) + s[1:]
 }
 
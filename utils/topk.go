@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// TopK 保留得分最高的k个元素，得分相同时保留先加入的元素
+type TopK[T any] struct {
+	k     int
+	seq   int
+	items topKHeap[T]
+}
+
+type topKItem[T any] struct {
+	item  T
+	score float64
+	seq   int
+}
+
+// topKHeap 最小堆，堆顶为得分最低且最晚加入的元素
+type topKHeap[T any] []*topKItem[T]
+
+func (h topKHeap[T]) Len() int { return len(h) }
+func (h topKHeap[T]) Less(i, j int) bool {
+	if h[i].score != h[j].score {
+		return h[i].score < h[j].score
+	}
+	return h[i].seq > h[j].seq
+}
+func (h topKHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *topKHeap[T]) Push(x any)   { *h = append(*h, x.(*topKItem[T])) }
+func (h *topKHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// NewTopK 创建保留k个元素的TopK
+func NewTopK[T any](k int) *TopK[T] {
+	return &TopK[T]{k: k}
+}
+
+// Accept 判断得分为score的元素是否会被保留，用于在构造元素前提前过滤
+func (t *TopK[T]) Accept(score float64) bool {
+	if t.k <= 0 {
+		return false
+	}
+	if len(t.items) < t.k {
+		return true
+	}
+	return score > t.items[0].score
+}
+
+// Push 加入元素，超出k个时淘汰得分最低的元素
+func (t *TopK[T]) Push(item T, score float64) {
+	if !t.Accept(score) {
+		return
+	}
+	heap.Push(&t.items, &topKItem[T]{item: item, score: score, seq: t.seq})
+	t.seq++
+	if len(t.items) > t.k {
+		heap.Pop(&t.items)
+	}
+}
+
+// Items 按加入顺序返回保留的元素
+func (t *TopK[T]) Items() []T {
+	kept := append([]*topKItem[T]{}, t.items...)
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i].seq < kept[j].seq
+	})
+
+	items := make([]T, 0, len(kept))
+	for _, it := range kept {
+		items = append(items, it.item)
+	}
+	return items
+}